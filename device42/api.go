@@ -0,0 +1,136 @@
+package device42
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	device42 "github.com/chopnico/device42-go"
+)
+
+// apiGet will get a path and decode the response into v
+func apiGet(c *device42.API, path string, v interface{}) error {
+	b, err := c.Do("GET", path, nil)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, v)
+}
+
+// apiPost will post parameters to a path and return the id of the object
+// that was added or updated
+func apiPost(c *device42.API, path string, p url.Values) (int, error) {
+	id, err := apiSend(c, "POST", path, p)
+	if err != nil {
+		return 0, err
+	}
+	if id == 0 {
+		return 0, errors.New("unable to read id from response to POST " + path)
+	}
+
+	return id, nil
+}
+
+// apiPut will put parameters to a path and return the id of the object
+// that was updated, if the appliance returned one
+func apiPut(c *device42.API, path string, p url.Values) (int, error) {
+	return apiSend(c, "PUT", path, p)
+}
+
+// apiDelete will delete a path
+func apiDelete(c *device42.API, path string) error {
+	_, err := c.Do("DELETE", path, nil)
+	return err
+}
+
+func apiSend(c *device42.API, method, path string, p url.Values) (int, error) {
+	b, err := c.Do(method, path, strings.NewReader(p.Encode()))
+	if err != nil {
+		return 0, err
+	}
+
+	apiResponse := device42.APIResponse{}
+	if err = json.Unmarshal(b, &apiResponse); err != nil {
+		return 0, err
+	}
+
+	msg, _ := apiResponse.Message.([]interface{})
+	if apiResponse.Code != 0 {
+		if len(msg) > 0 {
+			return 0, fmt.Errorf("%v", msg[0])
+		}
+		return 0, fmt.Errorf("%v", apiResponse.Message)
+	}
+
+	// device42 responds with [message, id, name, added, updated]
+	if len(msg) > 1 {
+		if id, ok := msg[1].(float64); ok {
+			return int(id), nil
+		}
+	}
+
+	return 0, nil
+}
+
+// apiPageSize is the most objects the appliance will return in one page
+const apiPageSize = 1000
+
+// apiGetList will page through a list endpoint using limit and offset and
+// decode every object listed under listKey into v, which must be a pointer
+// to a slice. when max is greater than 0 no more than max objects are read.
+func apiGetList(c *device42.API, path string, q url.Values, listKey string, max int, v interface{}) error {
+	p := url.Values{}
+	for k, i := range q {
+		p[k] = i
+	}
+
+	list := make([]json.RawMessage, 0)
+	for {
+		limit := apiPageSize
+		if max > 0 && max-len(list) < limit {
+			limit = max - len(list)
+		}
+		p.Set("limit", strconv.Itoa(limit))
+		p.Set("offset", strconv.Itoa(len(list)))
+
+		page := map[string]json.RawMessage{}
+		if err := apiGet(c, path+"?"+p.Encode(), &page); err != nil {
+			return err
+		}
+
+		objects := make([]json.RawMessage, 0)
+		if raw, ok := page[listKey]; ok {
+			if err := json.Unmarshal(raw, &objects); err != nil {
+				return err
+			}
+		}
+		list = append(list, objects...)
+
+		// endpoints without a total_count aren't paged
+		total := 0
+		if raw, ok := page["total_count"]; ok {
+			_ = json.Unmarshal(raw, &total)
+		}
+		if total == 0 || len(objects) == 0 || len(list) >= total {
+			break
+		}
+		if max > 0 && len(list) >= max {
+			break
+		}
+	}
+
+	if max > 0 && len(list) > max {
+		list = list[:max]
+	}
+
+	b, err := json.Marshal(list)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, v)
+}
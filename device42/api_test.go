@@ -0,0 +1,192 @@
+package device42
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	device42 "github.com/chopnico/device42-go"
+)
+
+type testObject struct {
+	ID int `json:"id"`
+}
+
+// testListServer serves count objects under listKey at /api/1.0/objects/. it
+// rejects pages larger than apiPageSize and returns no more than pageSize
+// objects per page, like an appliance with a lower limit would.
+type testListServer struct {
+	count          int
+	pageSize       int
+	withTotalCount bool
+	requests       []string
+}
+
+func (s *testListServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.requests = append(s.requests, r.URL.RawQuery)
+
+	if r.URL.Path != "/api/1.0/objects/" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	q := r.URL.Query()
+	limit, err := strconv.Atoi(q.Get("limit"))
+	if err != nil || limit < 1 || limit > apiPageSize {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	offset, err := strconv.Atoi(q.Get("offset"))
+	if err != nil || offset < 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if limit > s.pageSize {
+		limit = s.pageSize
+	}
+
+	objects := make([]testObject, 0)
+	for i := offset; i < s.count && len(objects) < limit; i++ {
+		objects = append(objects, testObject{ID: i + 1})
+	}
+
+	page := map[string]interface{}{"objects": objects}
+	if s.withTotalCount {
+		page["total_count"] = s.count
+		page["limit"] = limit
+		page["offset"] = offset
+	}
+	_ = json.NewEncoder(w).Encode(page)
+}
+
+func newTestAPI(t *testing.T, h http.Handler) *device42.API {
+	srv := httptest.NewTLSServer(h)
+	t.Cleanup(srv.Close)
+
+	c, err := device42.NewAPIBasicAuth("user", "password", strings.TrimPrefix(srv.URL, "https://"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c.IgnoreSSLErrors()
+}
+
+func TestAPIGetList(t *testing.T) {
+	tests := []struct {
+		name     string
+		server   testListServer
+		max      int
+		want     int
+		requests int
+	}{
+		{
+			name:     "pages through every object",
+			server:   testListServer{count: 2500, pageSize: apiPageSize, withTotalCount: true},
+			want:     2500,
+			requests: 3,
+		},
+		{
+			name:     "follows a smaller page size",
+			server:   testListServer{count: 750, pageSize: 300, withTotalCount: true},
+			want:     750,
+			requests: 3,
+		},
+		{
+			name:     "stops at total_count",
+			server:   testListServer{count: apiPageSize, pageSize: apiPageSize, withTotalCount: true},
+			want:     apiPageSize,
+			requests: 1,
+		},
+		{
+			name:     "reads one page without total_count",
+			server:   testListServer{count: 2500, pageSize: apiPageSize},
+			want:     apiPageSize,
+			requests: 1,
+		},
+		{
+			name:     "stops at max",
+			server:   testListServer{count: 2500, pageSize: apiPageSize, withTotalCount: true},
+			max:      1500,
+			want:     1500,
+			requests: 2,
+		},
+		{
+			name:     "max smaller than a page",
+			server:   testListServer{count: 2500, pageSize: apiPageSize, withTotalCount: true},
+			max:      10,
+			want:     10,
+			requests: 1,
+		},
+		{
+			name:     "no objects",
+			server:   testListServer{count: 0, pageSize: apiPageSize, withTotalCount: true},
+			want:     0,
+			requests: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := tt.server
+			c := newTestAPI(t, &s)
+
+			objects := make([]testObject, 0)
+			err := apiGetList(c, "/objects/", nil, "objects", tt.max, &objects)
+			if err != nil {
+				t.Fatalf("apiGetList() error = %v", err)
+			}
+
+			if len(objects) != tt.want {
+				t.Errorf("apiGetList() read %d objects, want %d", len(objects), tt.want)
+			}
+			for i, o := range objects {
+				if o.ID != i+1 {
+					t.Fatalf("object %d has id %d, want %d", i, o.ID, i+1)
+				}
+			}
+			if len(s.requests) != tt.requests {
+				t.Errorf("apiGetList() made %d requests, want %d : %v", len(s.requests), tt.requests, s.requests)
+			}
+		})
+	}
+}
+
+func TestAPIGetListLimitAndOffset(t *testing.T) {
+	s := testListServer{count: 1200, pageSize: apiPageSize, withTotalCount: true}
+	c := newTestAPI(t, &s)
+
+	objects := make([]testObject, 0)
+	q := map[string][]string{"name": {"a"}}
+	if err := apiGetList(c, "/objects/", q, "objects", 1100, &objects); err != nil {
+		t.Fatalf("apiGetList() error = %v", err)
+	}
+
+	want := []string{
+		"limit=1000&name=a&offset=0",
+		"limit=100&name=a&offset=1000",
+	}
+	if len(s.requests) != len(want) {
+		t.Fatalf("apiGetList() made requests %v, want %v", s.requests, want)
+	}
+	for i := range want {
+		if s.requests[i] != want[i] {
+			t.Errorf("request %d is %s, want %s", i, s.requests[i], want[i])
+		}
+	}
+
+	// the query of the caller isn't changed
+	if len(q) != 1 {
+		t.Errorf("apiGetList() changed the query to %v", q)
+	}
+}
+
+func TestAPIGetListError(t *testing.T) {
+	c := newTestAPI(t, &testListServer{})
+
+	objects := make([]testObject, 0)
+	if err := apiGetList(c, "/missing/", nil, "objects", 0, &objects); err == nil {
+		t.Error("apiGetList() error = nil, want an error")
+	}
+}
@@ -0,0 +1,176 @@
+package device42
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// customField type
+type customField struct {
+	Key   string      `json:"key"`
+	Notes string      `json:"notes"`
+	Value interface{} `json:"value"`
+}
+
+// customFieldsResourceSchema is the `custom_fields` argument of a resource
+func customFieldsResourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "The `custom_fields` managed on this object. Only keys set here are tracked for drift.",
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+// customFieldsDataSourceSchema is the `custom_fields` attribute of a data source
+func customFieldsDataSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "All `custom_fields` set on this object.",
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+// customFieldsFromInterface converts the untyped custom fields returned by
+// the device42 client
+func customFieldsFromInterface(i []interface{}) []customField {
+	fields := make([]customField, 0, len(i))
+	for _, v := range i {
+		f, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		cf := customField{
+			Key:   fmt.Sprintf("%v", f["key"]),
+			Value: f["value"],
+		}
+		if n, ok := f["notes"].(string); ok {
+			cf.Notes = n
+		}
+		fields = append(fields, cf)
+	}
+	return fields
+}
+
+func customFieldValue(v interface{}) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	default:
+		return fmt.Sprintf("%v", t)
+	}
+}
+
+// flattenCustomFields returns every custom field as a map
+func flattenCustomFields(fields []customField) map[string]interface{} {
+	cf := make(map[string]interface{})
+	for _, f := range fields {
+		cf[f.Key] = customFieldValue(f.Value)
+	}
+	return cf
+}
+
+// flattenManagedCustomFields returns only the custom fields that terraform
+// manages, so fields set by other tools don't show up as drift
+func flattenManagedCustomFields(fields []customField, d *schema.ResourceData) map[string]interface{} {
+	managed := d.Get("custom_fields").(map[string]interface{})
+	all := flattenCustomFields(fields)
+
+	cf := make(map[string]interface{})
+	for k := range managed {
+		if v, ok := all[k]; ok {
+			cf[k] = v
+		} else {
+			cf[k] = ""
+		}
+	}
+	return cf
+}
+
+// setCustomFields will write changed custom fields to the appliance. object
+// is the custom field endpoint (e.g., building) and p identifies the object
+// the fields belong to. fields removed from the configuration are cleared.
+func setCustomFields(c *device42.API, d *schema.ResourceData, object string, p url.Values) error {
+	o, n := d.GetChange("custom_fields")
+	oldFields := o.(map[string]interface{})
+	newFields := n.(map[string]interface{})
+
+	for k, v := range newFields {
+		if ov, ok := oldFields[k]; ok && ov == v {
+			continue
+		}
+		if err := setCustomField(c, object, p, k, v.(string)); err != nil {
+			return err
+		}
+	}
+
+	for k := range oldFields {
+		if _, ok := newFields[k]; ok {
+			continue
+		}
+		if err := setCustomField(c, object, p, k, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func setCustomField(c *device42.API, object string, p url.Values, key, value string) error {
+	v := url.Values{}
+	for k, i := range p {
+		v[k] = i
+	}
+	v.Set("key", key)
+	v.Set("value", value)
+
+	if _, err := apiPut(c, "/custom_fields/"+object+"/", v); err != nil {
+		return fmt.Errorf("unable to set custom field %s : %s", key, err.Error())
+	}
+
+	return nil
+}
+
+// customFieldsFromIP converts the custom fields of an IP
+func customFieldsFromIP(ip *device42.IP) []customField {
+	fields := make([]customField, len(ip.CustomFields))
+	for i, f := range ip.CustomFields {
+		fields[i] = customField{Key: f.Key, Notes: f.Notes, Value: f.Value}
+	}
+	return fields
+}
+
+// getVLANCustomFields will return the custom fields of a vlan, which the
+// device42 client doesn't decode
+func getVLANCustomFields(c *device42.API, id int) ([]customField, error) {
+	vlan := struct {
+		CustomFields []customField `json:"custom_fields"`
+	}{}
+
+	if err := apiGet(c, "/vlans/"+strconv.Itoa(id), &vlan); err != nil {
+		return nil, err
+	}
+
+	return vlan.CustomFields, nil
+}
+
+// ipCustomFieldParameters identifies an IP to the custom field endpoint
+func ipCustomFieldParameters(ip *device42.IP) url.Values {
+	p := url.Values{"ipaddress": {ip.Address}}
+	if ip.VRFGroup != "" {
+		p.Set("vrf_group", ip.VRFGroup)
+	}
+	return p
+}
@@ -0,0 +1,306 @@
+package device42
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// affinityConnection type. a connection is traffic discovered from a client
+// device to a port a listener device listens on.
+type affinityConnection struct {
+	ClientDeviceID     int    `json:"client_device_id"`
+	ClientDeviceName   string `json:"client_device"`
+	ClientIP           string `json:"client_ip"`
+	ListenerDeviceID   int    `json:"listener_device_id"`
+	ListenerDeviceName string `json:"listener_device"`
+	ListenerIP         string `json:"listener_ip"`
+	Port               int    `json:"port"`
+	Protocol           string `json:"protocol"`
+	Service            string `json:"service"`
+}
+
+// affinityEdge is a connection found by walking the dependencies of a device
+type affinityEdge struct {
+	affinityConnection
+	Direction string
+	Depth     int
+}
+
+func dataSourceAffinityGroup() *schema.Resource {
+	return &schema.Resource{
+		Description: "`device42_affinity_group` data source can be used to retrieve the dependencies Device42 discovered for a device or a business application, as a list of connections.",
+		ReadContext: dataSourceAffinityGroupRead,
+		Schema: map[string]*schema.Schema{
+			"device_id": &schema.Schema{
+				Description:  "The `device_id` of the device.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"device_id", "business_application_id"},
+			},
+			"business_application_id": &schema.Schema{
+				Description:  "The `business_application_id` of the business application. Its devices are the start of the group.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"device_id", "business_application_id"},
+			},
+			"direction": &schema.Schema{
+				Description:  "Which dependencies to return. `downstream` are the services the devices use, `upstream` are the clients that use the devices. (upstream, downstream or both)",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "both",
+				ValidateFunc: validation.StringInSlice([]string{"upstream", "downstream", "both"}, false),
+			},
+			"max_depth": &schema.Schema{
+				Description:  "The most connections away from the devices to follow. 0 follows every connection.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"edges": &schema.Schema{
+				Description: "The connections of the affinity group.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"direction": &schema.Schema{
+							Description: "Is the connection `upstream` or `downstream` of the devices?",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"depth": &schema.Schema{
+							Description: "How many connections away from the devices the connection is. Direct connections are 1.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"client_device_id": &schema.Schema{
+							Description: "The id of the client device.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"client_device_name": &schema.Schema{
+							Description: "The name of the client device.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"client_ip": &schema.Schema{
+							Description: "The IP the client connects from.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"listener_device_id": &schema.Schema{
+							Description: "The id of the listener device.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"listener_device_name": &schema.Schema{
+							Description: "The name of the listener device.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"listener_ip": &schema.Schema{
+							Description: "The IP the listener listens on.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"port": &schema.Schema{
+							Description: "The port the listener listens on.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"protocol": &schema.Schema{
+							Description: "The protocol of the connection. (e.g., tcp or udp)",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"service": &schema.Schema{
+							Description: "The name of the service that listens on the port.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// get the affinity group of a device or business application
+func dataSourceAffinityGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	roots := make([]int, 0)
+	if deviceID := d.Get("device_id").(int); deviceID != 0 {
+		roots = append(roots, deviceID)
+	} else {
+		appID := d.Get("business_application_id").(int)
+		app, err := getBusinessApplicationByID(c, appID)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("unable to get business application with id %d", appID),
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+		roots = append(roots, app.DeviceIDs...)
+	}
+
+	direction := d.Get("direction").(string)
+	maxDepth := d.Get("max_depth").(int)
+
+	edges := make([]affinityEdge, 0)
+	for _, dir := range []string{"downstream", "upstream"} {
+		if direction != "both" && direction != dir {
+			continue
+		}
+		e, err := walkAffinityGroup(c, roots, dir, maxDepth)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to get " + dir + " affinity group connections",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+		edges = append(edges, e...)
+	}
+
+	log.Printf("[DEBUG] affinity group of devices %v : %d edges", roots, len(edges))
+
+	err := d.Set("edges", flattenAffinityEdges(edges))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to set affinity group edges",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	ids := make([]int, 0, len(edges)*2)
+	for _, e := range edges {
+		ids = append(ids, e.ClientDeviceID, e.ListenerDeviceID)
+	}
+
+	d.SetId(listDataSourceID(d, dataSourceAffinityGroup(), ids))
+
+	return diags
+}
+
+// walkAffinityGroup will follow the connections from the root devices, one
+// depth at a time. downstream follows clients to the listeners they connect
+// to, upstream follows listeners back to their clients. only the connections
+// of the devices reached at a depth are fetched, every connection is returned
+// once, at the depth it was first reached, and the walk stops at maxDepth
+// when it is greater than 0.
+func walkAffinityGroup(c *device42.API, roots []int, direction string, maxDepth int) ([]affinityEdge, error) {
+	edges := make([]affinityEdge, 0)
+	seen := make(map[int]bool)
+
+	frontier := make([]int, 0, len(roots))
+	for _, i := range roots {
+		if !seen[i] {
+			seen[i] = true
+			frontier = append(frontier, i)
+		}
+	}
+
+	for depth := 1; len(frontier) > 0 && (maxDepth == 0 || depth <= maxDepth); depth++ {
+		connections, err := getAffinityConnections(c, direction, frontier)
+		if err != nil {
+			return nil, err
+		}
+
+		next := make([]int, 0)
+		for _, conn := range connections {
+			from, to := conn.ClientDeviceID, conn.ListenerDeviceID
+			if direction == "upstream" {
+				from, to = to, from
+			}
+			if !intInSlice(from, frontier) {
+				continue
+			}
+
+			edges = append(edges, affinityEdge{affinityConnection: conn, Direction: direction, Depth: depth})
+			if !seen[to] {
+				seen[to] = true
+				next = append(next, to)
+			}
+		}
+		frontier = next
+	}
+
+	return edges, nil
+}
+
+// flatten the edges of an affinity group to a map, by direction, depth,
+// client and listener
+func flattenAffinityEdges(edges []affinityEdge) []interface{} {
+	sort.SliceStable(edges, func(i, j int) bool {
+		a, b := edges[i], edges[j]
+		if a.Direction != b.Direction {
+			return a.Direction == "downstream"
+		}
+		if a.Depth != b.Depth {
+			return a.Depth < b.Depth
+		}
+		if a.ClientDeviceID != b.ClientDeviceID {
+			return a.ClientDeviceID < b.ClientDeviceID
+		}
+		if a.ListenerDeviceID != b.ListenerDeviceID {
+			return a.ListenerDeviceID < b.ListenerDeviceID
+		}
+		return a.Port < b.Port
+	})
+
+	l := make([]interface{}, len(edges))
+	for n, e := range edges {
+		l[n] = map[string]interface{}{
+			"direction":            e.Direction,
+			"depth":                e.Depth,
+			"client_device_id":     e.ClientDeviceID,
+			"client_device_name":   e.ClientDeviceName,
+			"client_ip":            e.ClientIP,
+			"listener_device_id":   e.ListenerDeviceID,
+			"listener_device_name": e.ListenerDeviceName,
+			"listener_ip":          e.ListenerIP,
+			"port":                 e.Port,
+			"protocol":             e.Protocol,
+			"service":              e.Service,
+		}
+	}
+	return l
+}
+
+// getAffinityConnections will return the connections discovered from the
+// devices as clients when direction is downstream, or to the devices as
+// listeners when it is upstream
+func getAffinityConnections(c *device42.API, direction string, deviceIDs []int) ([]affinityConnection, error) {
+	key := "client_device_id"
+	if direction == "upstream" {
+		key = "listener_device_id"
+	}
+	sorted := make([]int, len(deviceIDs))
+	copy(sorted, deviceIDs)
+	sort.Ints(sorted)
+
+	connections := make([]affinityConnection, 0)
+	q := url.Values{key: {intsToCommaString(sorted)}}
+	if err := apiGetList(c, "/affinity_groups/", q, "connections", 0, &connections); err != nil {
+		return nil, err
+	}
+
+	return connections, nil
+}
@@ -38,6 +38,7 @@ func dataSourceBuilding() *schema.Resource {
 				Computed:    true,
 				Description: "`notes` for a building.",
 			},
+			"custom_fields": customFieldsDataSourceSchema(),
 		},
 	}
 }
@@ -82,6 +83,7 @@ func dataSourceBuildingRead(ctx context.Context, d *schema.ResourceData, m inter
 	_ = d.Set("name", building.Name)
 	_ = d.Set("address", building.Address)
 	_ = d.Set("notes", building.Notes)
+	_ = d.Set("custom_fields", flattenCustomFields(customFieldsFromInterface(building.CustomFields)))
 
 	d.SetId(strconv.Itoa(building.BuildingID))
 
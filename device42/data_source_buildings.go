@@ -14,6 +14,10 @@ func dataSourceBuildings() *schema.Resource {
 		Description: "`device42_buildings` data source can be used to retrieve all buildings.",
 		ReadContext: dataSourceBuildingsRead,
 		Schema: map[string]*schema.Schema{
+			"max_results": maxResultsSchema(),
+			"filter":      filterSchema(),
+			"sort_by":     sortBySchema(),
+			"sort_order":  sortOrderSchema(),
 			"buildings": &schema.Schema{
 				Type:     schema.TypeList,
 				Computed: true,
@@ -52,7 +56,19 @@ func dataSourceBuildingsRead(ctx context.Context, d *schema.ResourceData, m inte
 
 	var diags diag.Diagnostics
 
-	buildings, err := c.GetBuildings()
+	filters, err := expandFilters(d, dataSourceBuildings().Schema["buildings"].Elem.(*schema.Resource))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "invalid filter",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	buildings := make([]device42.Building, 0)
+	q := serverSideFilters(filters, []string{"name"})
+	err = apiGetList(c, "/buildings/", q, "buildings", fetchMax(d, filters), &buildings)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -62,7 +78,9 @@ func dataSourceBuildingsRead(ctx context.Context, d *schema.ResourceData, m inte
 		return diags
 	}
 
-	bs := flattenBuildingsData(buildings)
+	bs := applyFilters(flattenBuildingsData(&buildings), filters)
+	sortItems(bs, d.Get("sort_by").(string), d.Get("sort_order").(string))
+	bs = limitItems(bs, d.Get("max_results").(int))
 	if err := d.Set("buildings", bs); err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -72,14 +90,12 @@ func dataSourceBuildingsRead(ctx context.Context, d *schema.ResourceData, m inte
 		return diags
 	}
 
-	ids := make([]int, len((*buildings)))
-	for _, i := range *buildings {
-		ids = append(ids, i.BuildingID)
+	ids := make([]int, 0, len(bs))
+	for _, i := range bs {
+		ids = append(ids, i.(map[string]interface{})["id"].(int))
 	}
 
-	checksum := idsChecksum(ids)
-
-	d.SetId(checksum)
+	d.SetId(listDataSourceID(d, dataSourceBuildings(), ids))
 
 	return diags
 }
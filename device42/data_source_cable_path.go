@@ -0,0 +1,190 @@
+package device42
+
+import (
+	"context"
+	"log"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceCablePath() *schema.Resource {
+	return &schema.Resource{
+		Description: "`device42_cable_path` data source can be used to trace the cables from a port, through patch panels, to the port at the far end.",
+		ReadContext: dataSourceCablePathRead,
+		Schema: map[string]*schema.Schema{
+			"port_type": &schema.Schema{
+				Description:  "The `port_type` of the port to trace from. (switch_port or patch_panel_port)",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"switch_port", "patch_panel_port"}, false),
+			},
+			"port_id": &schema.Schema{
+				Description: "The `port_id` of the port to trace from.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+			"side": &schema.Schema{
+				Description:  "The `side` of a patch panel port to trace from. (front or back) Defaults to front.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"front", "back"}, false),
+			},
+			"hops": &schema.Schema{
+				Description: "The cables along the path, in order.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cable_id": &schema.Schema{
+							Description: "The id of the cable.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"from_port_type": &schema.Schema{
+							Description: "The type of the port the cable leaves from.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"from_port_id": &schema.Schema{
+							Description: "The id of the port the cable leaves from.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"from_side": &schema.Schema{
+							Description: "The side of the patch panel port the cable leaves from.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"to_port_type": &schema.Schema{
+							Description: "The type of the port the cable arrives at.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"to_port_id": &schema.Schema{
+							Description: "The id of the port the cable arrives at.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"to_side": &schema.Schema{
+							Description: "The side of the patch panel port the cable arrives at.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"far_end_port_type": &schema.Schema{
+				Description: "The type of the port at the far end of the path.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"far_end_port_id": &schema.Schema{
+				Description: "The id of the port at the far end of the path.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"complete": &schema.Schema{
+				Description: "Does the path end at a switch port, rather than at a patch panel or nowhere?",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// trace the cables from a port
+func dataSourceCablePathRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	start := normalizeCableEnd(cableEnd{
+		PortType: d.Get("port_type").(string),
+		PortID:   d.Get("port_id").(int),
+		Side:     d.Get("side").(string),
+	})
+
+	hops, farEnd, err := traceCablePath(func(end cableEnd) (*cable, cableEnd, error) {
+		return cableAtPort(c, end)
+	}, start)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to trace the cables from " + cableEndKey(start),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	log.Printf("[DEBUG] cable path from %s : %d hops to %s", cableEndKey(start), len(hops), cableEndKey(farEnd))
+
+	if err := d.Set("hops", hops); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to set cable path",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	_ = d.Set("far_end_port_type", farEnd.PortType)
+	_ = d.Set("far_end_port_id", farEnd.PortID)
+	_ = d.Set("complete", len(hops) > 0 && farEnd.PortType == "switch_port")
+
+	d.SetId(cableEndKey(start))
+
+	return diags
+}
+
+// traceCablePath will follow the cables from a port. a path continues
+// through a patch panel port from one side to the other, and stops at a
+// switch port, at a port with nothing connected or when it loops. at looks
+// up the cable connected to a port, so only the cables along the path are
+// read.
+func traceCablePath(at func(cableEnd) (*cable, cableEnd, error), start cableEnd) ([]interface{}, cableEnd, error) {
+	hops := make([]interface{}, 0)
+	visited := make(map[string]bool)
+
+	end := start
+	from := start
+	for !visited[cableEndKey(from)] {
+		visited[cableEndKey(from)] = true
+
+		cb, to, err := at(from)
+		if err != nil {
+			return nil, cableEnd{}, err
+		}
+		if cb == nil {
+			break
+		}
+
+		hops = append(hops, map[string]interface{}{
+			"cable_id":       cb.ID,
+			"from_port_type": from.PortType,
+			"from_port_id":   from.PortID,
+			"from_side":      from.Side,
+			"to_port_type":   to.PortType,
+			"to_port_id":     to.PortID,
+			"to_side":        to.Side,
+		})
+		end = to
+
+		if to.PortType != "patch_panel_port" {
+			break
+		}
+		visited[cableEndKey(to)] = true
+
+		// continue out of the other side of the patch panel port
+		from = to
+		if to.Side == "front" {
+			from.Side = "back"
+		} else {
+			from.Side = "front"
+		}
+	}
+
+	return hops, end, nil
+}
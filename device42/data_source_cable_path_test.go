@@ -0,0 +1,113 @@
+package device42
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func testCables() []cable {
+	return []cable{
+		// switch port 1 to the front of patch panel port 10, whose back is
+		// cabled to the back of patch panel port 20 and on to switch port 2
+		{ID: 1, A: cableEnd{PortType: "switch_port", PortID: 1}, B: cableEnd{PortType: "patch_panel_port", PortID: 10, Side: "front"}},
+		{ID: 2, A: cableEnd{PortType: "patch_panel_port", PortID: 10, Side: "back"}, B: cableEnd{PortType: "patch_panel_port", PortID: 20, Side: "back"}},
+		{ID: 3, A: cableEnd{PortType: "switch_port", PortID: 2}, B: cableEnd{PortType: "patch_panel_port", PortID: 20}},
+		// a loop through both sides of patch panel port 30
+		{ID: 4, A: cableEnd{PortType: "patch_panel_port", PortID: 30, Side: "front"}, B: cableEnd{PortType: "patch_panel_port", PortID: 30, Side: "back"}},
+	}
+}
+
+func TestTraceCablePath(t *testing.T) {
+	cables := testCables()
+	at := func(end cableEnd) (*cable, cableEnd, error) {
+		cb, to := cableAt(cables, end)
+		return cb, to, nil
+	}
+
+	tests := []struct {
+		name   string
+		start  cableEnd
+		cables []int
+		farEnd cableEnd
+	}{
+		{
+			name:   "through patch panels",
+			start:  cableEnd{PortType: "switch_port", PortID: 1},
+			cables: []int{1, 2, 3},
+			farEnd: cableEnd{PortType: "switch_port", PortID: 2},
+		},
+		{
+			name:   "from a patch panel port",
+			start:  cableEnd{PortType: "patch_panel_port", PortID: 20, Side: "front"},
+			cables: []int{3},
+			farEnd: cableEnd{PortType: "switch_port", PortID: 2},
+		},
+		{
+			name:   "nothing connected",
+			start:  cableEnd{PortType: "switch_port", PortID: 5},
+			cables: []int{},
+			farEnd: cableEnd{PortType: "switch_port", PortID: 5},
+		},
+		{
+			name:   "loop",
+			start:  cableEnd{PortType: "patch_panel_port", PortID: 30, Side: "front"},
+			cables: []int{4},
+			farEnd: cableEnd{PortType: "patch_panel_port", PortID: 30, Side: "back"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hops, farEnd, err := traceCablePath(at, normalizeCableEnd(tt.start))
+			if err != nil {
+				t.Fatalf("traceCablePath() error = %v", err)
+			}
+			got := make([]int, 0)
+			for _, h := range hops {
+				got = append(got, h.(map[string]interface{})["cable_id"].(int))
+			}
+			if !reflect.DeepEqual(got, tt.cables) {
+				t.Errorf("traceCablePath() cables = %v, want %v", got, tt.cables)
+			}
+			if farEnd != tt.farEnd {
+				t.Errorf("traceCablePath() far end = %v, want %v", farEnd, tt.farEnd)
+			}
+		})
+	}
+}
+
+func TestCableAtPort(t *testing.T) {
+	requests := 0
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		q := r.URL.Query()
+		cables := make([]cable, 0)
+		for _, cb := range testCables() {
+			for prefix, end := range map[string]cableEnd{"a_": cb.A, "b_": cb.B} {
+				if q.Get(prefix+"port_type") == end.PortType && q.Get(prefix+"port_id") == strconv.Itoa(end.PortID) {
+					cables = append(cables, cb)
+				}
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"cables": cables, "total_count": len(cables)})
+	})
+	c := newTestAPI(t, h)
+
+	cb, to, err := cableAtPort(c, cableEnd{PortType: "patch_panel_port", PortID: 30, Side: "back"})
+	if err != nil {
+		t.Fatalf("cableAtPort() error = %v", err)
+	}
+	if cb == nil || cb.ID != 4 || to.Side != "front" {
+		t.Errorf("cableAtPort() = %v, %v, want cable 4 to the front side", cb, to)
+	}
+	if requests != 2 {
+		t.Errorf("cableAtPort() made %d requests, want one for each end", requests)
+	}
+
+	if cb, _, err := cableAtPort(c, cableEnd{PortType: "switch_port", PortID: 5}); err != nil || cb != nil {
+		t.Errorf("cableAtPort() = %v, %v, want no cable", cb, err)
+	}
+}
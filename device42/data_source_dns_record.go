@@ -0,0 +1,146 @@
+package device42
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceDNSRecord() *schema.Resource {
+	return &schema.Resource{
+		Description: "`device42_dns_record` data source can be used to retrieve a single DNS record using its `id`, or its `name` along with its `zone` or `type`.",
+		ReadContext: dataSourceDNSRecordRead,
+		Schema: map[string]*schema.Schema{
+			"id": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				AtLeastOneOf: []string{"id", "name"},
+				Description:  "The `id` of a DNS record.",
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "name"},
+				Description:  "The fully qualified `name` of a DNS record.",
+			},
+			"zone": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the DNS `zone` of a DNS record.",
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(dnsRecordTypes, false),
+				Description:  "The `type` of a DNS record.",
+			},
+			"content": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The `content` of the DNS record.",
+			},
+			"ttl": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The `ttl` of the DNS record.",
+			},
+			"priority": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The `priority` of an MX or SRV record.",
+			},
+		},
+	}
+}
+
+// get a dns record by id, or by name
+func dataSourceDNSRecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+	var err error
+
+	recordID := d.Get("id").(int)
+	record := &dnsRecord{}
+
+	if recordID != 0 {
+		log.Printf("[DEBUG] dns record id : %d", recordID)
+		record, err = getDNSRecordByID(c, recordID)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to get dns record with id " + strconv.Itoa(recordID),
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	} else {
+		q := url.Values{}
+		q.Set("name", d.Get("name").(string))
+		if v := d.Get("zone").(string); v != "" {
+			q.Set("domain", v)
+		}
+		if v := d.Get("type").(string); v != "" {
+			q.Set("type", v)
+		}
+
+		log.Printf("[DEBUG] dns record query : %s", q.Encode())
+
+		records, err := getDNSRecords(c, q)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to get dns records matching " + q.Encode(),
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+
+		switch len(records) {
+		case 0:
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to find dns record",
+				Detail:   "no dns record matched " + q.Encode(),
+			})
+			return diags
+		case 1:
+			record = &records[0]
+		default:
+			ids := make([]int, len(records))
+			for n, i := range records {
+				ids[n] = i.ID
+			}
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "more than one dns record matched",
+				Detail:   fmt.Sprintf("dns records with ids %s matched %s. use zone or type to narrow the search.", intsToCommaString(ids), q.Encode()),
+			})
+			return diags
+		}
+	}
+
+	log.Printf("[DEBUG] dns record : %v", record)
+
+	_ = d.Set("zone", record.Zone)
+	_ = d.Set("type", record.Type)
+	_ = d.Set("name", record.Name)
+	_ = d.Set("content", record.Content)
+	_ = d.Set("ttl", record.TTL)
+	_ = d.Set("priority", record.Priority)
+
+	d.SetId(strconv.Itoa(record.ID))
+
+	return diags
+}
@@ -0,0 +1,95 @@
+package device42
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDNSZone() *schema.Resource {
+	return &schema.Resource{
+		Description: "`device42_dns_zone` data source can be used to retrieve a single DNS zone using its `id` or `name`.",
+		ReadContext: dataSourceDNSZoneRead,
+		Schema: map[string]*schema.Schema{
+			"id": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				AtLeastOneOf: []string{"id", "name"},
+				Description:  "The `id` of a DNS zone.",
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"id", "name"},
+				Description:  "The `name` of a DNS zone.",
+			},
+			"nameserver": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The `nameserver` that is authoritative for the DNS zone.",
+			},
+			"ttl": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The default `ttl` of records in the DNS zone.",
+			},
+			"notes": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "`notes` for the DNS zone.",
+			},
+		},
+	}
+}
+
+// get a dns zone by id or name
+func dataSourceDNSZoneRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+	var err error
+
+	zoneID := d.Get("id").(int)
+	zoneName := d.Get("name").(string)
+	zone := &dnsZone{}
+
+	if zoneID != 0 {
+		log.Printf("[DEBUG] dns zone id : %d", zoneID)
+		zone, err = getDNSZoneByID(c, zoneID)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to get dns zone with id " + strconv.Itoa(zoneID),
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	} else if zoneName != "" {
+		log.Printf("[DEBUG] dns zone name : %s", zoneName)
+		zone, err = getDNSZoneByName(c, zoneName)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to get dns zone with name " + zoneName,
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	log.Printf("[DEBUG] dns zone : %v", zone)
+
+	_ = d.Set("name", zone.Name)
+	_ = d.Set("nameserver", zone.Nameserver)
+	_ = d.Set("ttl", zone.TTL)
+	_ = d.Set("notes", zone.Notes)
+
+	d.SetId(strconv.Itoa(zone.ID))
+
+	return diags
+}
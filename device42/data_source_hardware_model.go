@@ -0,0 +1,119 @@
+package device42
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceHardwareModel() *schema.Resource {
+	return &schema.Resource{
+		Description: "`device42_hardware_model` data source can be used to retrieve a single hardware model using its `id` or `name`.",
+		ReadContext: dataSourceHardwareModelRead,
+		Schema: map[string]*schema.Schema{
+			"id": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				AtLeastOneOf: []string{"id", "name"},
+				Description:  "The `id` of a hardware model.",
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"id", "name"},
+				Description:  "The `name` of a hardware model.",
+			},
+			"vendor": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the `vendor` of the hardware model.",
+			},
+			"type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The `type` of the hardware model.",
+			},
+			"size": &schema.Schema{
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The `size` of the hardware model in rack units.",
+			},
+			"depth": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The `depth` of the hardware model.",
+			},
+			"watts": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The power the hardware model draws in `watts`.",
+			},
+			"part_number": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The `part_number` of the hardware model.",
+			},
+			"notes": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "`notes` for the hardware model.",
+			},
+		},
+	}
+}
+
+// get a hardware model by id or name
+func dataSourceHardwareModelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+	var err error
+
+	modelID := d.Get("id").(int)
+	modelName := d.Get("name").(string)
+	model := &hardwareModel{}
+
+	if modelID != 0 {
+		log.Printf("[DEBUG] hardware model id : %d", modelID)
+		model, err = getHardwareModelByID(c, modelID)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to get hardware model with id " + strconv.Itoa(modelID),
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	} else if modelName != "" {
+		log.Printf("[DEBUG] hardware model name : %s", modelName)
+		model, err = getHardwareModelByName(c, modelName)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to get hardware model with name " + modelName,
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	log.Printf("[DEBUG] hardware model : %v", model)
+
+	_ = d.Set("name", model.Name)
+	_ = d.Set("vendor", model.Manufacturer)
+	_ = d.Set("type", hardwareModelAttribute(model.Type))
+	_ = d.Set("size", model.Size)
+	_ = d.Set("depth", hardwareModelAttribute(model.Depth))
+	_ = d.Set("watts", model.Watts)
+	_ = d.Set("part_number", model.PartNumber)
+	_ = d.Set("notes", model.Notes)
+
+	d.SetId(strconv.Itoa(model.ID))
+
+	return diags
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/url"
 	"strconv"
 
 	device42 "github.com/chopnico/device42-go"
@@ -14,46 +15,60 @@ import (
 
 func dataSourceIP() *schema.Resource {
 	return &schema.Resource{
-		Description: "`device42_ip` data source can be used to retrieve a single IP using its `address` and a `subnet_id`.",
+		Description: "`device42_ip` data source can be used to retrieve a single IP using its `id`, its `address` (optionally scoped by `subnet_id` or `vrf_group_id`), its `label` or its `mac_address`.",
 		ReadContext: dataSourceIPRead,
 		Schema: map[string]*schema.Schema{
 			"id": &schema.Schema{
-				Description: "The `id` of an IP.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:  "The `id` of an IP.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "address", "label", "mac_address"},
 			},
 			"label": &schema.Schema{
-				Description: "The `lablel` of the IP.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:  "The `lablel` of the IP.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "address", "label", "mac_address"},
 			},
 			"address": &schema.Schema{
 				Description:  "The `address` of the IP.",
 				Type:         schema.TypeString,
 				Optional:     true,
-				RequiredWith: []string{"subnet_id"},
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "address", "label", "mac_address"},
 			},
 			"mac_address": &schema.Schema{
-				Description: "The `mac_address` of the IP.",
-				Type:        schema.TypeString,
-				Computed:    true,
+				Description:  "The `mac_address` of the IP.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "address", "label", "mac_address"},
 			},
 			"subnet_id": &schema.Schema{
-				Description:  "The `subnet_id` of the IP.",
-				Type:         schema.TypeInt,
-				Optional:     true,
-				RequiredWith: []string{"address"},
+				Description: "The `subnet_id` of the IP.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"vrf_group_id": &schema.Schema{
+				Description: "The `vrf_group_id` of the IP.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
 			},
 			"subnet": &schema.Schema{
 				Description: "The `subnet` of the IP. (e.g., 192.168.0.0/24)",
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"custom_fields": customFieldsDataSourceSchema(),
 		},
 	}
 }
 
-// get a building by id
+// get an ip by id, address, label or mac address
 func dataSourceIPRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*device42.API)
 
@@ -62,23 +77,26 @@ func dataSourceIPRead(ctx context.Context, d *schema.ResourceData, m interface{}
 
 	ipID := d.Get("id").(string)
 	ipAddress := d.Get("address").(string)
+	ipLabel := d.Get("label").(string)
+	ipMacAddress := d.Get("mac_address").(string)
 	ipSubnetID := d.Get("subnet_id").(int)
+	ipVRFGroupID := d.Get("vrf_group_id").(int)
 	ip := &device42.IP{}
 
-	var id int
-	_, err = fmt.Sscan(ipID, &id)
-	if err != nil {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "unable to suggest ip",
-			Detail:   err.Error(),
-		})
-		return diags
-	}
-
 	if ipID != "" {
 		log.Printf("[DEBUG] ip id: %s\n", ipID)
 
+		var id int
+		_, err = fmt.Sscan(ipID, &id)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read ip id " + ipID,
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+
 		ip, err = c.GetIPByID(id)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
@@ -88,18 +106,63 @@ func dataSourceIPRead(ctx context.Context, d *schema.ResourceData, m interface{}
 			})
 			return diags
 		}
-	} else if ipAddress != "" && ipSubnetID != 0 {
-		log.Printf("[DEBUG] ip address: %s\n", ipAddress)
+	} else {
+		q := url.Values{}
+		if ipAddress != "" {
+			q.Set("address", ipAddress)
+		}
+		if ipLabel != "" {
+			q.Set("label", ipLabel)
+		}
+		if ipMacAddress != "" {
+			q.Set("mac", ipMacAddress)
+		}
+		if ipSubnetID != 0 {
+			q.Set("subnet_id", strconv.Itoa(ipSubnetID))
+		}
+
+		log.Printf("[DEBUG] ip query: %s\n", q.Encode())
 
-		ip, err = c.GetIPByAddressWithSubnetID(ipAddress, ipSubnetID)
+		ips, err := getIPs(c, q, 0)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
-				Summary:  "unable to get subnet with address " + ipAddress + "and subnet id " + strconv.Itoa(ipSubnetID),
+				Summary:  "unable to get ips matching " + q.Encode(),
 				Detail:   err.Error(),
 			})
 			return diags
 		}
+
+		matches := make([]device42.IP, 0, len(ips))
+		for _, i := range ips {
+			if ipVRFGroupID != 0 && i.VRFGroupID != ipVRFGroupID {
+				continue
+			}
+			matches = append(matches, i)
+		}
+
+		switch len(matches) {
+		case 0:
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to find ip",
+				Detail:   "no ip matched " + q.Encode(),
+			})
+			return diags
+		case 1:
+			ip = &matches[0]
+		default:
+			ids := make([]int, len(matches))
+			for n, i := range matches {
+				ids[n] = i.ID
+			}
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "more than one ip matched",
+				Detail:   fmt.Sprintf("ips with ids %s matched %s. use subnet_id or vrf_group_id to narrow the search.", intsToCommaString(ids), q.Encode()),
+			})
+			return diags
+		}
 	}
 
 	c.WriteToDebugLog(fmt.Sprintf("ip : %v", ip))
@@ -107,10 +170,23 @@ func dataSourceIPRead(ctx context.Context, d *schema.ResourceData, m interface{}
 	_ = d.Set("address", ip.Address)
 	_ = d.Set("subnet", ip.Subnet)
 	_ = d.Set("subnet_id", ip.SubnetID)
+	_ = d.Set("vrf_group_id", ip.VRFGroupID)
 	_ = d.Set("label", ip.Label)
 	_ = d.Set("mac_address", ip.MacAddress)
+	_ = d.Set("custom_fields", flattenCustomFields(customFieldsFromIP(ip)))
 
 	d.SetId(strconv.Itoa(ip.ID))
 
 	return diags
 }
+
+// getIPs will return the ips matching a query, reading no more than max
+// when max is greater than 0
+func getIPs(c *device42.API, q url.Values, max int) ([]device42.IP, error) {
+	ips := make([]device42.IP, 0)
+	if err := apiGetList(c, "/ips/", q, "ips", max, &ips); err != nil {
+		return nil, err
+	}
+
+	return ips, nil
+}
@@ -0,0 +1,204 @@
+package device42
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceIPNATs() *schema.Resource {
+	return &schema.Resource{
+		Description: "`device42_ip_nats` data source can be used to retrieve the NATs an IP is the inside or outside IP of.",
+		ReadContext: dataSourceIPNATsRead,
+		Schema: map[string]*schema.Schema{
+			"ip_id": &schema.Schema{
+				Description:  "The `ip_id` of the IP.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"ip_id", "address"},
+			},
+			"address": &schema.Schema{
+				Description:  "The `address` of the IP.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"ip_id", "address"},
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"subnet_id": &schema.Schema{
+				Description:   "The `subnet_id` of the IP, when the address exists in more than one subnet.",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"ip_id"},
+			},
+			"nats": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "All `nats` of the IP.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Description: "The `id` of the NAT.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"direction": &schema.Schema{
+							Description: "Is the IP the `inside` or the `outside` IP of the NAT?",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"inside_ip_id": &schema.Schema{
+							Description: "The id of the inside IP.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"inside_address": &schema.Schema{
+							Description: "The address of the inside IP.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"inside_port": &schema.Schema{
+							Description: "The inside port of a port NAT.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"outside_ip_id": &schema.Schema{
+							Description: "The id of the outside IP.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"outside_address": &schema.Schema{
+							Description: "The address of the outside IP.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"outside_port": &schema.Schema{
+							Description: "The outside port of a port NAT.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"type": &schema.Schema{
+							Description: "The `type` of NAT.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"protocol": &schema.Schema{
+							Description: "The `protocol` of a port NAT.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"network_device_id": &schema.Schema{
+							Description: "The device id of the network device doing the NAT.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// get the nats of an ip
+func dataSourceIPNATsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	ipID, err := natIPID(c, d.Get("ip_id").(int), d.Get("address").(string), d.Get("subnet_id").(int))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get ip",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	nats, err := getIPNATsOfIP(c, ipID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get a list of ip nats",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	err = d.Set("nats", flattenIPNATsData(nats, ipID))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to set ip nats",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	_ = d.Set("ip_id", ipID)
+
+	d.SetId(strconv.Itoa(ipID))
+
+	return diags
+}
+
+// getIPNATsOfIP will return the nats an ip is the inside or the outside
+// address of. device42 can only filter on one side at a time, so both sides
+// are queried and a nat of an ip to itself is only returned once.
+func getIPNATsOfIP(c *device42.API, ipID int) ([]ipNAT, error) {
+	nats := make([]ipNAT, 0)
+	seen := make(map[int]bool)
+	for _, k := range []string{"ip_address_from_id", "ip_address_to_id"} {
+		side, err := getIPNATs(c, url.Values{k: {strconv.Itoa(ipID)}})
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range side {
+			if !seen[i.ID] {
+				seen[i.ID] = true
+				nats = append(nats, i)
+			}
+		}
+	}
+
+	return nats, nil
+}
+
+// flatten the nats of an ip to a map
+func flattenIPNATsData(nats []ipNAT, ipID int) []interface{} {
+	ns := make([]interface{}, 0)
+	for _, nat := range nats {
+		direction := ""
+		switch ipID {
+		case nat.InsideIPID:
+			direction = "inside"
+		case nat.OutsideIPID:
+			direction = "outside"
+		default:
+			continue
+		}
+
+		n := make(map[string]interface{})
+
+		n["id"] = nat.ID
+		n["direction"] = direction
+		n["inside_ip_id"] = nat.InsideIPID
+		n["inside_address"] = nat.InsideAddress
+		n["inside_port"] = nat.InsidePort
+		n["outside_ip_id"] = nat.OutsideIPID
+		n["outside_address"] = nat.OutsideAddress
+		n["outside_port"] = nat.OutsidePort
+		n["type"] = nat.Type
+		n["protocol"] = nat.Protocol
+		n["network_device_id"] = nat.NetworkDeviceID
+
+		ns = append(ns, n)
+	}
+	return ns
+}
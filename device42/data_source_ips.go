@@ -0,0 +1,194 @@
+package device42
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceIPs() *schema.Resource {
+	return &schema.Resource{
+		Description: "`device42_ips` data source can be used to retrieve IPs filtered by subnet, label, availability or tags.",
+		ReadContext: dataSourceIPsRead,
+		Schema: map[string]*schema.Schema{
+			"subnet_id": &schema.Schema{
+				Description: "Filter by `subnet_id`",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"label_prefix": &schema.Schema{
+				Description: "Only include IPs with a label starting with `label_prefix`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"available": &schema.Schema{
+				Description:  "Filter by availability. (yes or no)",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"yes", "no"}, false),
+			},
+			"tags": &schema.Schema{
+				Description: "Only include IPs with any of these `tags`.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"max_results": maxResultsSchema(),
+			"ips": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "All matching `ips`",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Description: "The `id` of the IP.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"address": &schema.Schema{
+							Description: "The `address` of the IP.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"label": &schema.Schema{
+							Description: "The `label` of the IP.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"mac_address": &schema.Schema{
+							Description: "The `mac_address` of the IP.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"available": &schema.Schema{
+							Description: "Is the IP `available`?",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"subnet": &schema.Schema{
+							Description: "The `subnet` of the IP.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"subnet_id": &schema.Schema{
+							Description: "The `subnet_id` of the IP.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"vrf_group_id": &schema.Schema{
+							Description: "The `vrf_group_id` of the IP.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// get ips
+func dataSourceIPsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	subnetID := d.Get("subnet_id").(int)
+	labelPrefix := d.Get("label_prefix").(string)
+	available := d.Get("available").(string)
+	tags := interfaceSliceToStringSlice(d.Get("tags").([]interface{}))
+
+	q := url.Values{}
+	if subnetID != 0 {
+		q.Set("subnet_id", strconv.Itoa(subnetID))
+	}
+	if available != "" {
+		q.Set("available", available)
+	}
+	if len(tags) > 0 {
+		q.Set("tags", strings.Join(tags, ","))
+	}
+
+	maxResults := d.Get("max_results").(int)
+
+	// the label prefix is matched here, so everything has to be read first
+	fetch := maxResults
+	if labelPrefix != "" {
+		fetch = 0
+	}
+
+	ips, err := getIPs(c, q, fetch)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get a list of ips",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	matches := make([]device42.IP, 0, len(ips))
+	for _, i := range ips {
+		if maxResults > 0 && len(matches) >= maxResults {
+			break
+		}
+		if labelPrefix != "" && !strings.HasPrefix(i.Label, labelPrefix) {
+			continue
+		}
+		matches = append(matches, i)
+	}
+
+	is := flattenIPsData(&matches)
+	if err := d.Set("ips", is); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to set ips",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	ids := make([]int, 0, len(matches))
+	for _, i := range matches {
+		ids = append(ids, i.ID)
+	}
+
+	d.SetId(listDataSourceID(d, dataSourceIPs(), ids))
+
+	return diags
+}
+
+// flatten ips to a map
+func flattenIPsData(ips *[]device42.IP) []interface{} {
+	if ips != nil {
+		is := make([]interface{}, len(*ips))
+
+		for n, ip := range *ips {
+			i := make(map[string]interface{})
+
+			i["id"] = ip.ID
+			i["address"] = ip.Address
+			i["label"] = ip.Label
+			i["mac_address"] = ip.MacAddress
+			i["available"] = ip.Available
+			i["subnet"] = ip.Subnet
+			i["subnet_id"] = ip.SubnetID
+			i["vrf_group_id"] = ip.VRFGroupID
+
+			is[n] = i
+		}
+
+		return is
+	}
+
+	return make([]interface{}, 0)
+}
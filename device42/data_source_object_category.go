@@ -0,0 +1,83 @@
+package device42
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceObjectCategory() *schema.Resource {
+	return &schema.Resource{
+		Description: "`device42_object_category` data source can be used to retrieve a single object category using its `id` or `name`.",
+		ReadContext: dataSourceObjectCategoryRead,
+		Schema: map[string]*schema.Schema{
+			"id": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				AtLeastOneOf: []string{"id", "name"},
+				Description:  "The `id` of an object category.",
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"id", "name"},
+				Description:  "The `name` of an object category.",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The `description` of an object category.",
+			},
+		},
+	}
+}
+
+// get an object category by id or name
+func dataSourceObjectCategoryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+	var err error
+
+	categoryID := d.Get("id").(int)
+	categoryName := d.Get("name").(string)
+	category := &objectCategory{}
+
+	if categoryID != 0 {
+		log.Printf("[DEBUG] object category id : %d", categoryID)
+		category, err = getObjectCategoryByID(c, categoryID)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to get object category with id " + strconv.Itoa(categoryID),
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	} else if categoryName != "" {
+		log.Printf("[DEBUG] object category name : %s", categoryName)
+		category, err = getObjectCategoryByName(c, categoryName)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to get object category with name " + categoryName,
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	log.Printf("[DEBUG] object category : %v", category)
+
+	_ = d.Set("name", category.Name)
+	_ = d.Set("description", category.Description)
+
+	d.SetId(strconv.Itoa(category.ID))
+
+	return diags
+}
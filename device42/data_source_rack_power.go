@@ -0,0 +1,159 @@
+package device42
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceRackPower() *schema.Resource {
+	return &schema.Resource{
+		Description: "`device42_rack_power` data source can be used to retrieve the power capacity and allocation of the PDUs in a rack.",
+		ReadContext: dataSourceRackPowerRead,
+		Schema: map[string]*schema.Schema{
+			"rack_id": &schema.Schema{
+				Description: "The `rack_id` of the rack.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+			"capacity_watts": &schema.Schema{
+				Description: "The total `capacity_watts` of the PDUs in the rack.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"allocated_watts": &schema.Schema{
+				Description: "The total `allocated_watts` of the power connections to the PDUs in the rack.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"available_watts": &schema.Schema{
+				Description: "The `available_watts` left in the rack.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"utilization_percent": &schema.Schema{
+				Description: "The share of the capacity that is allocated.",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"pdus": &schema.Schema{
+				Description: "The power allocation of each PDU in the rack.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pdu_id": &schema.Schema{
+							Description: "The `pdu_id` of the PDU.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"name": &schema.Schema{
+							Description: "The `name` of the PDU.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"capacity_watts": &schema.Schema{
+							Description: "The `capacity_watts` of the PDU.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"allocated_watts": &schema.Schema{
+							Description: "The `allocated_watts` of the power connections to the PDU.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"outlets_used": &schema.Schema{
+							Description: "The number of outlets with a power connection.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// get the power allocation of a rack
+func dataSourceRackPowerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	rackID := d.Get("rack_id").(int)
+
+	pdus, err := getPDUs(c, url.Values{"rack_id": {strconv.Itoa(rackID)}})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get pdus of rack with id " + strconv.Itoa(rackID),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	capacity, allocated := 0, 0
+	ps := make([]interface{}, 0, len(pdus))
+	for _, p := range pdus {
+		if p.RackID != rackID {
+			continue
+		}
+
+		connections, err := getPowerConnections(c, url.Values{"pdu_id": {strconv.Itoa(p.ID)}})
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to get power connections of pdu with id " + strconv.Itoa(p.ID),
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+
+		watts, outlets := 0, make(map[int]bool)
+		for _, i := range connections {
+			if i.PDUID != p.ID {
+				continue
+			}
+			watts += i.AllocatedWatts
+			outlets[i.OutletNumber] = true
+		}
+
+		capacity += p.CapacityWatts
+		allocated += watts
+
+		ps = append(ps, map[string]interface{}{
+			"pdu_id":          p.ID,
+			"name":            p.Name,
+			"capacity_watts":  p.CapacityWatts,
+			"allocated_watts": watts,
+			"outlets_used":    len(outlets),
+		})
+	}
+
+	utilization := 0.0
+	if capacity > 0 {
+		utilization = float64(allocated) / float64(capacity) * 100
+	}
+
+	if err := d.Set("pdus", ps); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to set pdus",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	_ = d.Set("capacity_watts", capacity)
+	_ = d.Set("allocated_watts", allocated)
+	_ = d.Set("available_watts", capacity-allocated)
+	_ = d.Set("utilization_percent", utilization)
+
+	d.SetId(strconv.Itoa(rackID))
+
+	return diags
+}
@@ -0,0 +1,77 @@
+package device42
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceServiceLevel() *schema.Resource {
+	return &schema.Resource{
+		Description: "`device42_service_level` data source can be used to retrieve a single service level using its `id` or `name`.",
+		ReadContext: dataSourceServiceLevelRead,
+		Schema: map[string]*schema.Schema{
+			"id": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				AtLeastOneOf: []string{"id", "name"},
+				Description:  "The `id` of a service level.",
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"id", "name"},
+				Description:  "The `name` of a service level.",
+			},
+		},
+	}
+}
+
+// get a service level by id or name
+func dataSourceServiceLevelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+	var err error
+
+	levelID := d.Get("id").(int)
+	levelName := d.Get("name").(string)
+	level := &serviceLevel{}
+
+	if levelID != 0 {
+		log.Printf("[DEBUG] service level id : %d", levelID)
+		level, err = getServiceLevelByID(c, levelID)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to get service level with id " + strconv.Itoa(levelID),
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	} else if levelName != "" {
+		log.Printf("[DEBUG] service level name : %s", levelName)
+		level, err = getServiceLevelByName(c, levelName)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to get service level with name " + levelName,
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	log.Printf("[DEBUG] service level : %v", level)
+
+	_ = d.Set("name", level.Name)
+
+	d.SetId(strconv.Itoa(level.ID))
+
+	return diags
+}
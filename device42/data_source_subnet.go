@@ -67,6 +67,83 @@ func dataSourceSubnet() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"vlan_ids": &schema.Schema{
+				Description: "The `vlan_ids` associated with the subnet.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"used_count": &schema.Schema{
+				Description: "The number of addresses used by IPs or child subnets. Usage is only worked out for IPv4 subnets.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"free_count": &schema.Schema{
+				Description: "The number of free addresses of an IPv4 subnet.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"utilization_percent": &schema.Schema{
+				Description: "The percentage of addresses of an IPv4 subnet used.",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"free_ranges": &schema.Schema{
+				Description: "The ranges of free addresses of an IPv4 subnet.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start": &schema.Schema{
+							Description: "The first free address.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"end": &schema.Schema{
+							Description: "The last free address.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"count": &schema.Schema{
+							Description: "The number of addresses in the range.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"child_subnets": &schema.Schema{
+				Description: "The direct `child_subnets` of the subnet.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Description: "The `id` of the child subnet.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"name": &schema.Schema{
+							Description: "The `name` of the child subnet.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"network": &schema.Schema{
+							Description: "The `network` of the child subnet.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"mask_bits": &schema.Schema{
+							Description: "The `mask_bits` of the child subnet.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"custom_fields": customFieldsDataSourceSchema(),
 		},
 	}
 }
@@ -134,6 +211,43 @@ func dataSourceSubnetRead(ctx context.Context, d *schema.ResourceData, m interfa
 	_ = d.Set("mask_bits", subnet.MaskBits)
 	_ = d.Set("vrf_group_id", subnet.VrfGroupID)
 	_ = d.Set("tags", subnet.Tags)
+	_ = d.Set("custom_fields", flattenCustomFields(customFieldsFromInterface(subnet.CustomFields)))
+
+	vlanIDs, err := getSubnetVLANIDs(c, subnet.SubnetID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get VLANs for subnet with id " + strconv.Itoa(subnet.SubnetID),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	_ = d.Set("vlan_ids", vlanIDs)
+
+	usage, children, err := getSubnetUsage(c, subnet)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "unable to get usage of subnet with id " + strconv.Itoa(subnet.SubnetID),
+			Detail:   err.Error(),
+		})
+	}
+
+	cs := make([]interface{}, len(children))
+	for i, child := range children {
+		cs[i] = map[string]interface{}{
+			"id":        child.SubnetID,
+			"name":      child.Name,
+			"network":   child.Network,
+			"mask_bits": child.MaskBits,
+		}
+	}
+
+	_ = d.Set("used_count", usage.Used)
+	_ = d.Set("free_count", usage.Free)
+	_ = d.Set("utilization_percent", usage.UtilizationPercent())
+	_ = d.Set("free_ranges", flattenFreeRanges(usage.FreeRange))
+	_ = d.Set("child_subnets", cs)
 
 	d.SetId(strconv.Itoa(subnet.SubnetID))
 
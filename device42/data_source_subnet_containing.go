@@ -0,0 +1,201 @@
+package device42
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"strconv"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceSubnetContaining() *schema.Resource {
+	return &schema.Resource{
+		Description: "`device42_subnet_containing` data source can be used to retrieve the most specific subnet containing an `address` or a `cidr`.",
+		ReadContext: dataSourceSubnetContainingRead,
+		Schema: map[string]*schema.Schema{
+			"address": &schema.Schema{
+				Description:  "The `address` the subnet must contain. (e.g., 192.168.0.10)",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"address", "cidr"},
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"cidr": &schema.Schema{
+				Description:  "The `cidr` the subnet must contain. (e.g., 192.168.0.0/26)",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"address", "cidr"},
+				ValidateFunc: validation.IsCIDR,
+			},
+			"vrf_group_id": &schema.Schema{
+				Description: "Only search subnets in this `vrf_group_id`.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"subnet_id": &schema.Schema{
+				Description: "The `id` of the containing subnet.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"name": &schema.Schema{
+				Description: "The `name` of the containing subnet.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"network": &schema.Schema{
+				Description: "The `network` of the containing subnet.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"mask_bits": &schema.Schema{
+				Description: "The `mask_bits` of the containing subnet.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"mask": &schema.Schema{
+				Description: "The `mask` of the containing subnet.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"gateway": &schema.Schema{
+				Description: "The `gateway` of the containing subnet.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"parent_subnet_id": &schema.Schema{
+				Description: "The `parent_subnet_id` of the containing subnet.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"tags": &schema.Schema{
+				Description: "All `tags` for the containing subnet.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+// get the most specific subnet containing an address or cidr
+func dataSourceSubnetContainingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	address := d.Get("address").(string)
+	cidr := d.Get("cidr").(string)
+	vrfGroupID := d.Get("vrf_group_id").(int)
+
+	var target *net.IPNet
+	if address != "" {
+		ip := net.ParseIP(address)
+		bits := 128
+		if ip.To4() != nil {
+			ip = ip.To4()
+			bits = 32
+		}
+		target = &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+	} else {
+		_, target, _ = net.ParseCIDR(cidr)
+	}
+
+	log.Printf("[DEBUG] subnet containing : %s\n", target.String())
+
+	q := url.Values{}
+	if vrfGroupID != 0 {
+		q.Set("vrf_group_id", strconv.Itoa(vrfGroupID))
+	}
+
+	subnets, err := getSubnets(c, q, 0)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get a list of subnets",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	matches := longestPrefixSubnets(*subnets, target)
+	switch len(matches) {
+	case 0:
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to find subnet",
+			Detail:   "no subnet contains " + target.String(),
+		})
+		return diags
+	case 1:
+	default:
+		ids := make([]int, len(matches))
+		for i, s := range matches {
+			ids[i] = s.SubnetID
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "more than one subnet matched",
+			Detail:   fmt.Sprintf("subnets with ids %s contain %s. use vrf_group_id to narrow the search.", intsToCommaString(ids), target.String()),
+		})
+		return diags
+	}
+
+	subnet := matches[0]
+
+	c.WriteToDebugLog(fmt.Sprintf("%v", subnet))
+
+	_, subnetNet, _ := net.ParseCIDR(subnet.Network + "/" + strconv.Itoa(subnet.MaskBits))
+	if len(subnetNet.Mask) == net.IPv4len {
+		_ = d.Set("mask", ipv4MaskString(subnetNet.Mask))
+	}
+
+	_ = d.Set("subnet_id", subnet.SubnetID)
+	_ = d.Set("name", subnet.Name)
+	_ = d.Set("network", subnet.Network)
+	_ = d.Set("mask_bits", subnet.MaskBits)
+	_ = d.Set("gateway", subnet.Gateway)
+	_ = d.Set("vrf_group_id", subnet.VrfGroupID)
+	_ = d.Set("parent_subnet_id", subnet.ParentSubnetID)
+	_ = d.Set("tags", subnet.Tags)
+
+	d.SetId(strconv.Itoa(subnet.SubnetID))
+
+	return diags
+}
+
+// longestPrefixSubnets will return the most specific subnets containing
+// target. more than one is returned when vrf groups overlap.
+func longestPrefixSubnets(subnets []device42.Subnet, target *net.IPNet) []device42.Subnet {
+	targetOnes, targetBits := target.Mask.Size()
+
+	best := -1
+	matches := make([]device42.Subnet, 0)
+	for _, s := range subnets {
+		_, n, err := net.ParseCIDR(s.Network + "/" + strconv.Itoa(s.MaskBits))
+		if err != nil {
+			continue
+		}
+		ones, bits := n.Mask.Size()
+		if bits != targetBits || ones > targetOnes || !n.Contains(target.IP) {
+			continue
+		}
+		if ones > best {
+			best = ones
+			matches = matches[:0]
+		}
+		if ones == best {
+			matches = append(matches, s)
+		}
+	}
+	return matches
+}
@@ -0,0 +1,106 @@
+package device42
+
+import (
+	"net"
+	"reflect"
+	"testing"
+
+	device42 "github.com/chopnico/device42-go"
+)
+
+func testSubnets(cidrs ...string) []device42.Subnet {
+	subnets := make([]device42.Subnet, len(cidrs))
+	for n, i := range cidrs {
+		ip, ipNet, err := net.ParseCIDR(i)
+		if err != nil {
+			panic(err)
+		}
+		ones, _ := ipNet.Mask.Size()
+		subnets[n] = device42.Subnet{SubnetID: n + 1, Network: ip.String(), MaskBits: ones}
+	}
+	return subnets
+}
+
+func testTarget(s string) *net.IPNet {
+	if _, ipNet, err := net.ParseCIDR(s); err == nil {
+		return ipNet
+	}
+	ip := net.ParseIP(s)
+	if ip4 := ip.To4(); ip4 != nil {
+		return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}
+}
+
+func TestLongestPrefixSubnets(t *testing.T) {
+	subnets := testSubnets(
+		"10.0.0.0/8",
+		"10.1.0.0/16",
+		"10.1.2.0/24",
+		"10.1.2.0/24",
+		"10.1.2.128/25",
+		"192.168.0.0/16",
+		"fd00::/48",
+		"fd00:0:0:1::/64",
+	)
+
+	tests := []struct {
+		name   string
+		target string
+		want   []int
+	}{
+		{
+			name:   "most specific subnet of an address",
+			target: "10.1.3.4",
+			want:   []int{2},
+		},
+		{
+			name:   "ties are all returned",
+			target: "10.1.2.4",
+			want:   []int{3, 4},
+		},
+		{
+			name:   "more specific subnet than the ties",
+			target: "10.1.2.200",
+			want:   []int{5},
+		},
+		{
+			name:   "a cidr is only contained by subnets at most as specific",
+			target: "10.1.2.0/23",
+			want:   []int{2},
+		},
+		{
+			name:   "a cidr contains itself",
+			target: "10.1.2.128/25",
+			want:   []int{5},
+		},
+		{
+			name:   "ipv6 address",
+			target: "fd00:0:0:1::5",
+			want:   []int{8},
+		},
+		{
+			name:   "ipv6 cidr",
+			target: "fd00:0:0:2::/64",
+			want:   []int{7},
+		},
+		{
+			name:   "no subnet",
+			target: "172.16.0.1",
+			want:   []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := testTarget(tt.target)
+			got := make([]int, 0)
+			for _, s := range longestPrefixSubnets(subnets, target) {
+				got = append(got, s.SubnetID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("longestPrefixSubnets(%s) = %v, want %v", tt.target, got, tt.want)
+			}
+		})
+	}
+}
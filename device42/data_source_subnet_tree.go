@@ -0,0 +1,277 @@
+package device42
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strconv"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceSubnetTree() *schema.Resource {
+	return &schema.Resource{
+		Description: "`device42_subnet_tree` data source can be used to retrieve a subnet and all of its descendants, flattened into a list.",
+		ReadContext: dataSourceSubnetTreeRead,
+		Schema: map[string]*schema.Schema{
+			"root_subnet_id": &schema.Schema{
+				Description:  "The `id` of the root subnet.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"root_subnet_id", "root_cidr"},
+			},
+			"root_cidr": &schema.Schema{
+				Description:  "The network of the root subnet. (e.g., 10.0.0.0/8)",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"root_subnet_id", "root_cidr"},
+				ValidateFunc: validation.IsCIDR,
+			},
+			"vrf_group_id": &schema.Schema{
+				Description: "The `vrf_group_id` of the tree. Defaults to the root subnet's VRF group.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"subnets": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The `subnets` of the tree, depth first starting with the root.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Description: "The `id` of the subnet.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"name": &schema.Schema{
+							Description: "The `name` of the subnet.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"network": &schema.Schema{
+							Description: "The `network` of the subnet.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"mask_bits": &schema.Schema{
+							Description: "The `mask_bits` of the subnet.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"depth": &schema.Schema{
+							Description: "The `depth` of the subnet. The root is 0.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"parent_subnet_id": &schema.Schema{
+							Description: "The `parent_subnet_id` of the subnet.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"children_ids": &schema.Schema{
+							Description: "The ids of the direct children of the subnet.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+						"utilization_percent": &schema.Schema{
+							Description: "The percentage of addresses used by IPs or child subnets.",
+							Type:        schema.TypeFloat,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// get a subnet tree
+func dataSourceSubnetTreeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+	var err error
+
+	rootID := d.Get("root_subnet_id").(int)
+	rootCIDR := d.Get("root_cidr").(string)
+	vrfGroupID := d.Get("vrf_group_id").(int)
+	root := &device42.Subnet{}
+
+	if rootID != 0 {
+		root, err = c.GetSubnetByID(rootID)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to get subnet with id " + strconv.Itoa(rootID),
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	} else {
+		root, err = getSubnetByCIDR(c, rootCIDR, vrfGroupID)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to get subnet with network " + rootCIDR,
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	if vrfGroupID == 0 {
+		vrfGroupID = root.VrfGroupID
+	}
+
+	subnets, err := getSubnets(c, url.Values{"vrf_group_id": {strconv.Itoa(vrfGroupID)}}, 0)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get subnets with vrf group id " + strconv.Itoa(vrfGroupID),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	children := make(map[int][]device42.Subnet)
+	for _, s := range *subnets {
+		children[s.ParentSubnetID] = append(children[s.ParentSubnetID], s)
+	}
+	for _, s := range children {
+		sortSubnets(s)
+	}
+
+	// one request for the ips of the whole vrf group, rather than one per
+	// subnet
+	ips, err := getIPs(c, url.Values{"vrf_group_id": {strconv.Itoa(vrfGroupID)}}, 0)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get ips with vrf group id " + strconv.Itoa(vrfGroupID),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	ipsBySubnet := make(map[int][]device42.IP)
+	for _, ip := range ips {
+		ipsBySubnet[ip.SubnetID] = append(ipsBySubnet[ip.SubnetID], ip)
+	}
+
+	ss := make([]interface{}, 0)
+	ids := make([]int, 0)
+	visited := make(map[int]bool)
+
+	var walk func(subnet device42.Subnet, depth int)
+	walk = func(subnet device42.Subnet, depth int) {
+		// a parent and child cycle would otherwise never end
+		if visited[subnet.SubnetID] {
+			return
+		}
+		visited[subnet.SubnetID] = true
+
+		utilization := 0.0
+		if usage, err := calculateSubnetUsage(&subnet, ipsBySubnet[subnet.SubnetID], children[subnet.SubnetID]); err == nil {
+			utilization = usage.UtilizationPercent()
+		}
+
+		childrenIDs := make([]int, len(children[subnet.SubnetID]))
+		for i, child := range children[subnet.SubnetID] {
+			childrenIDs[i] = child.SubnetID
+		}
+
+		ss = append(ss, map[string]interface{}{
+			"id":                  subnet.SubnetID,
+			"name":                subnet.Name,
+			"network":             subnet.Network,
+			"mask_bits":           subnet.MaskBits,
+			"depth":               depth,
+			"parent_subnet_id":    subnet.ParentSubnetID,
+			"children_ids":        childrenIDs,
+			"utilization_percent": utilization,
+		})
+		ids = append(ids, subnet.SubnetID)
+
+		for _, child := range children[subnet.SubnetID] {
+			walk(child, depth+1)
+		}
+	}
+
+	walk(*root, 0)
+
+	if err := d.Set("subnets", ss); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to set subnets",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	_ = d.Set("vrf_group_id", vrfGroupID)
+
+	d.SetId(listDataSourceID(d, dataSourceSubnetTree(), ids))
+
+	return diags
+}
+
+// getSubnetByCIDR will return the subnet with a network in a vrf group
+func getSubnetByCIDR(c *device42.API, cidr string, vrfGroupID int) (*device42.Subnet, error) {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, err
+	}
+	ones, _ := ipNet.Mask.Size()
+
+	q := url.Values{
+		"network":   {ipNet.IP.String()},
+		"mask_bits": {strconv.Itoa(ones)},
+	}
+	if vrfGroupID != 0 {
+		q.Set("vrf_group_id", strconv.Itoa(vrfGroupID))
+	}
+
+	subnets, err := getSubnets(c, q, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]device42.Subnet, 0, len(*subnets))
+	for _, s := range *subnets {
+		if s.Network == ipNet.IP.String() && s.MaskBits == ones {
+			matches = append(matches, s)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no subnet with network %s", cidr)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("%d subnets with network %s, use vrf_group_id to narrow the search", len(matches), cidr)
+	}
+}
+
+// sortSubnets will sort subnets by network address
+func sortSubnets(subnets []device42.Subnet) {
+	sort.Slice(subnets, func(i, j int) bool {
+		a := net.ParseIP(subnets[i].Network).To16()
+		b := net.ParseIP(subnets[j].Network).To16()
+		if c := bytes.Compare(a, b); c != 0 {
+			return c < 0
+		}
+		return subnets[i].MaskBits < subnets[j].MaskBits
+	})
+}
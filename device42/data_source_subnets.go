@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/url"
 	"strconv"
 
 	device42 "github.com/chopnico/device42-go"
@@ -27,6 +28,10 @@ func dataSourceSubnets() *schema.Resource {
 				Type:        schema.TypeInt,
 				Optional:    true,
 			},
+			"max_results": maxResultsSchema(),
+			"filter":      filterSchema(),
+			"sort_by":     sortBySchema(),
+			"sort_order":  sortOrderSchema(),
 			"subnets": &schema.Schema{
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -93,19 +98,26 @@ func dataSourceSubnetsRead(ctx context.Context, d *schema.ResourceData, m interf
 	parentSubnetID := d.Get("parent_subnet_id").(int)
 
 	var diags diag.Diagnostics
-	var err error
-	var subnets *[]device42.Subnet
-
-	if vrfGroupID != 0 && parentSubnetID != 0 {
-		subnets, err = c.GetSubnetsByParentSubnetIDWithVRFGroupID(parentSubnetID, vrfGroupID)
-	} else if vrfGroupID != 0 {
-		subnets, err = c.GetSubnetsByVRFGroupID(vrfGroupID)
-	} else if parentSubnetID != 0 {
-		subnets, err = c.GetSubnetsByParentSubnetID(parentSubnetID)
-	} else {
-		subnets, err = c.GetSubnets()
+
+	filters, err := expandFilters(d, dataSourceSubnets().Schema["subnets"].Elem.(*schema.Resource))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "invalid filter",
+			Detail:   err.Error(),
+		})
+		return diags
 	}
 
+	q := serverSideFilters(filters, []string{"name", "network", "mask_bits", "parent_subnet_id"})
+	if vrfGroupID != 0 {
+		q.Set("vrf_group_id", strconv.Itoa(vrfGroupID))
+	}
+	if parentSubnetID != 0 {
+		q.Set("parent_subnet_id", strconv.Itoa(parentSubnetID))
+	}
+
+	subnets, err := getSubnets(c, q, fetchMax(d, filters))
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -115,7 +127,9 @@ func dataSourceSubnetsRead(ctx context.Context, d *schema.ResourceData, m interf
 		return diags
 	}
 
-	s := flattenSubnetsData(subnets, d)
+	s := applyFilters(flattenSubnetsData(subnets, d), filters)
+	sortItems(s, d.Get("sort_by").(string), d.Get("sort_order").(string))
+	s = limitItems(s, d.Get("max_results").(int))
 	if err := d.Set("subnets", s); err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -125,14 +139,12 @@ func dataSourceSubnetsRead(ctx context.Context, d *schema.ResourceData, m interf
 		return diags
 	}
 
-	ids := make([]int, len((*subnets)))
-	for _, i := range *subnets {
-		ids = append(ids, i.SubnetID)
+	ids := make([]int, 0, len(s))
+	for _, i := range s {
+		ids = append(ids, i.(map[string]interface{})["id"].(int))
 	}
 
-	checksum := idsChecksum(ids)
-
-	d.SetId(checksum)
+	d.SetId(listDataSourceID(d, dataSourceSubnets(), ids))
 
 	return diags
 }
@@ -164,3 +176,14 @@ func flattenSubnetsData(subnets *[]device42.Subnet, d *schema.ResourceData) []in
 
 	return make([]interface{}, 0)
 }
+
+// getSubnets will return the subnets matching a query, reading no more than
+// max when max is greater than 0
+func getSubnets(c *device42.API, q url.Values, max int) (*[]device42.Subnet, error) {
+	subnets := make([]device42.Subnet, 0)
+	if err := apiGetList(c, "/subnets/", q, "subnets", max, &subnets); err != nil {
+		return nil, err
+	}
+
+	return &subnets, nil
+}
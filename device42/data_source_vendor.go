@@ -0,0 +1,101 @@
+package device42
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceVendor() *schema.Resource {
+	return &schema.Resource{
+		Description: "`device42_vendor` data source can be used to retrieve a single vendor using its `id` or `name`.",
+		ReadContext: dataSourceVendorRead,
+		Schema: map[string]*schema.Schema{
+			"id": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				AtLeastOneOf: []string{"id", "name"},
+				Description:  "The `id` of a vendor.",
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"id", "name"},
+				Description:  "The `name` of a vendor.",
+			},
+			"home_page": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The `home_page` of the vendor.",
+			},
+			"phone": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The `phone` number of the vendor.",
+			},
+			"email": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The `email` address of the vendor.",
+			},
+			"notes": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "`notes` for the vendor.",
+			},
+		},
+	}
+}
+
+// get a vendor by id or name
+func dataSourceVendorRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+	var err error
+
+	vendorID := d.Get("id").(int)
+	vendorName := d.Get("name").(string)
+	v := &vendor{}
+
+	if vendorID != 0 {
+		log.Printf("[DEBUG] vendor id : %d", vendorID)
+		v, err = getVendorByID(c, vendorID)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to get vendor with id " + strconv.Itoa(vendorID),
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	} else if vendorName != "" {
+		log.Printf("[DEBUG] vendor name : %s", vendorName)
+		v, err = getVendorByName(c, vendorName)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to get vendor with name " + vendorName,
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	log.Printf("[DEBUG] vendor : %v", v)
+
+	_ = d.Set("name", v.Name)
+	_ = d.Set("home_page", v.HomePage)
+	_ = d.Set("phone", v.Phone)
+	_ = d.Set("email", v.Email)
+	_ = d.Set("notes", v.Notes)
+
+	d.SetId(strconv.Itoa(v.ID))
+
+	return diags
+}
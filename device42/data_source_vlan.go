@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/url"
 	"strconv"
 
 	device42 "github.com/chopnico/device42-go"
@@ -14,24 +15,66 @@ import (
 
 func dataSourceVLAN() *schema.Resource {
 	return &schema.Resource{
-		Description: "`device42_vlan' data source can be used to retrieve a single VLAN using its `id`",
+		Description: "`device42_vlan' data source can be used to retrieve a single VLAN using its `id`, its `number` or its `name`. A `number` can be scoped by `switch_id` or `tag`.",
 		ReadContext: dataSourceVLANRead,
 		Schema: map[string]*schema.Schema{
 			"id": &schema.Schema{
-				Description: "The `id` of a VLAN.",
-				Type:        schema.TypeInt,
-				Required:    true,
+				Description:  "The `id` of a VLAN.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "number", "name"},
 			},
 			"name": &schema.Schema{
-				Description: "The `name` of the VLAN.",
-				Type:        schema.TypeString,
-				Computed:    true,
+				Description:  "The `name` of the VLAN.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "number", "name"},
 			},
 			"number": &schema.Schema{
-				Description: "The VLAN `number.",
+				Description:  "The VLAN `number.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "number", "name"},
+			},
+			"switch_id": &schema.Schema{
+				Description: "Only match a VLAN bound to this `switch_id`.",
 				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"tag": &schema.Schema{
+				Description: "Only match a VLAN with this `tag`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"description": &schema.Schema{
+				Description: "The `description` of the VLAN.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"notes": &schema.Schema{
+				Description: "`notes` for the VLAN.",
+				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"switch_ids": &schema.Schema{
+				Description: "The device ids of the `switch_ids` the VLAN is bound to.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"subnet_ids": &schema.Schema{
+				Description: "The `subnet_ids` associated with the VLAN.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
 			"tags": &schema.Schema{
 				Description: "All`tags` for a VLAN.",
 				Type:        schema.TypeList,
@@ -40,11 +83,12 @@ func dataSourceVLAN() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"custom_fields": customFieldsDataSourceSchema(),
 		},
 	}
 }
 
-// get a building by id
+// get a vlan by id, number or name
 func dataSourceVLANRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*device42.API)
 
@@ -52,6 +96,8 @@ func dataSourceVLANRead(ctx context.Context, d *schema.ResourceData, m interface
 	var err error
 
 	vlanID := d.Get("id").(int)
+	vlanNumber := d.Get("number").(int)
+	vlanName := d.Get("name").(string)
 	vlan := &device42.VLAN{}
 
 	if vlanID != 0 {
@@ -66,15 +112,97 @@ func dataSourceVLANRead(ctx context.Context, d *schema.ResourceData, m interface
 			})
 			return diags
 		}
+	} else if vlanNumber != 0 || vlanName != "" {
+		log.Printf("[DEBUG] VLAN number: %d, name: %s\n", vlanNumber, vlanName)
+
+		vlans, err := getVLANs(c, url.Values{}, 0)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to get a list of VLANs",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+
+		matches := filterVLANs(vlans, vlanNumber, vlanName, d.Get("switch_id").(int), d.Get("tag").(string))
+		switch len(matches) {
+		case 0:
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to find VLAN",
+				Detail:   fmt.Sprintf("no VLAN matched number %d and name %q", vlanNumber, vlanName),
+			})
+			return diags
+		case 1:
+			vlan = &matches[0]
+		default:
+			ids := make([]int, len(matches))
+			for i, v := range matches {
+				ids[i] = v.VlanID
+			}
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "more than one VLAN matched",
+				Detail:   fmt.Sprintf("VLANs with ids %s matched number %d and name %q. use switch_id or tag to narrow the search.", intsToCommaString(ids), vlanNumber, vlanName),
+			})
+			return diags
+		}
 	}
 
 	c.WriteToDebugLog(fmt.Sprintf("%v", vlan))
 
 	_ = d.Set("name", vlan.Name)
 	_ = d.Set("number", vlan.Number)
+	_ = d.Set("description", vlan.Description)
+	_ = d.Set("notes", vlan.Notes)
 	_ = d.Set("tags", vlan.Tags)
+	_ = d.Set("switch_ids", vlanSwitchIDs(vlan))
+
+	subnetIDs, err := getSubnetIDsByVLANID(c, vlan.VlanID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get subnets for VLAN with id " + strconv.Itoa(vlan.VlanID),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	_ = d.Set("subnet_ids", subnetIDs)
+
+	customFields, err := getVLANCustomFields(c, vlan.VlanID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get custom fields for VLAN with id " + strconv.Itoa(vlan.VlanID),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	_ = d.Set("custom_fields", flattenCustomFields(customFields))
 
 	d.SetId(strconv.Itoa(vlan.VlanID))
 
 	return diags
 }
+
+// filterVLANs will return the vlans that match every non zero argument
+func filterVLANs(vlans []device42.VLAN, number int, name string, switchID int, tag string) []device42.VLAN {
+	matches := make([]device42.VLAN, 0)
+	for _, v := range vlans {
+		if number != 0 && v.Number != number {
+			continue
+		}
+		if name != "" && v.Name != name {
+			continue
+		}
+		if switchID != 0 && !intInSlice(switchID, vlanSwitchIDs(&v)) {
+			continue
+		}
+		if tag != "" && !stringInSlice(tag, v.Tags) {
+			continue
+		}
+		matches = append(matches, v)
+	}
+	return matches
+}
@@ -0,0 +1,206 @@
+package device42
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strings"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceVLANs() *schema.Resource {
+	return &schema.Resource{
+		Description: "`device42_vlans` data source can be used to retrieve VLANs filtered by number range, name or tags.",
+		ReadContext: dataSourceVLANsRead,
+		Schema: map[string]*schema.Schema{
+			"number_min": &schema.Schema{
+				Description:  "Only include VLANs with a number greater than or equal to `number_min`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 4094),
+			},
+			"number_max": &schema.Schema{
+				Description:  "Only include VLANs with a number less than or equal to `number_max`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 4094),
+			},
+			"name_regex": &schema.Schema{
+				Description:  "Only include VLANs with a name matching `name_regex`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"tags": &schema.Schema{
+				Description: "Only include VLANs with all of these `tags`.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"max_results": maxResultsSchema(),
+			"vlans": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "All matching `vlans`",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Description: "The `id` of the VLAN.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"name": &schema.Schema{
+							Description: "The `name` of the VLAN.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"number": &schema.Schema{
+							Description: "The VLAN `number`.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"description": &schema.Schema{
+							Description: "The `description` of the VLAN.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"notes": &schema.Schema{
+							Description: "`notes` for the VLAN.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"tags": &schema.Schema{
+							Description: "The `tags` of the VLAN.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"switch_ids": &schema.Schema{
+							Description: "The device ids of the `switch_ids` the VLAN is bound to.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// get vlans
+func dataSourceVLANsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	numberMin := d.Get("number_min").(int)
+	numberMax := d.Get("number_max").(int)
+	nameRegex := d.Get("name_regex").(string)
+	tags := interfaceSliceToStringSlice(d.Get("tags").([]interface{}))
+
+	q := url.Values{}
+	if len(tags) > 0 {
+		q.Set("tags_and", strings.Join(tags, ","))
+	}
+
+	vlans, err := getVLANs(c, q, 0)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get a list of VLANs",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	var re *regexp.Regexp
+	if nameRegex != "" {
+		re = regexp.MustCompile(nameRegex)
+	}
+
+	maxResults := d.Get("max_results").(int)
+
+	matches := make([]device42.VLAN, 0)
+	for _, v := range vlans {
+		if maxResults > 0 && len(matches) >= maxResults {
+			break
+		}
+		if numberMin != 0 && v.Number < numberMin {
+			continue
+		}
+		if numberMax != 0 && v.Number > numberMax {
+			continue
+		}
+		if re != nil && !re.MatchString(v.Name) {
+			continue
+		}
+		matches = append(matches, v)
+	}
+
+	vs := flattenVLANsData(&matches)
+	if err := d.Set("vlans", vs); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to set VLANs",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	ids := make([]int, 0, len(matches))
+	for _, i := range matches {
+		ids = append(ids, i.VlanID)
+	}
+
+	d.SetId(listDataSourceID(d, dataSourceVLANs(), ids))
+
+	return diags
+}
+
+// flatten vlans to a map
+func flattenVLANsData(vlans *[]device42.VLAN) []interface{} {
+	if vlans != nil {
+		vs := make([]interface{}, len(*vlans))
+
+		for i, vlan := range *vlans {
+			v := make(map[string]interface{})
+
+			v["id"] = vlan.VlanID
+			v["name"] = vlan.Name
+			v["number"] = vlan.Number
+			v["description"] = vlan.Description
+			v["notes"] = vlan.Notes
+			v["tags"] = vlan.Tags
+			v["switch_ids"] = vlanSwitchIDs(&vlan)
+
+			vs[i] = v
+		}
+
+		return vs
+	}
+
+	return make([]interface{}, 0)
+}
+
+// getVLANs will return the vlans matching a query, reading no more than max
+// when max is greater than 0
+func getVLANs(c *device42.API, q url.Values, max int) ([]device42.VLAN, error) {
+	vlans := make([]device42.VLAN, 0)
+	if err := apiGetList(c, "/vlans/", q, "vlans", max, &vlans); err != nil {
+		return nil, err
+	}
+
+	return vlans, nil
+}
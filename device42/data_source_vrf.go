@@ -0,0 +1,113 @@
+package device42
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceVRF() *schema.Resource {
+	return &schema.Resource{
+		Description: "`device42_vrf` data source can be used to retrieve a single VRF using its `id` or `name`.",
+		ReadContext: dataSourceVRFRead,
+		Schema: map[string]*schema.Schema{
+			"id": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				AtLeastOneOf: []string{"id", "name"},
+				Description:  "The `id` of a VRF.",
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"id", "name"},
+				Description:  "The `name` of a VRF.",
+			},
+			"route_distinguisher": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The `route_distinguisher` of the VRF.",
+			},
+			"import_route_targets": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The `import_route_targets` of the VRF.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"export_route_targets": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The `export_route_targets` of the VRF.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The `description` of the VRF.",
+			},
+			"vrf_group_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The `vrf_group_id` of the VRF group the VRF belongs to.",
+			},
+		},
+	}
+}
+
+// get a vrf by id or name
+func dataSourceVRFRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+	var err error
+
+	vrfID := d.Get("id").(int)
+	vrfName := d.Get("name").(string)
+	v := &vrf{}
+
+	if vrfID != 0 {
+		log.Printf("[DEBUG] vrf id : %d", vrfID)
+		v, err = getVRFByID(c, vrfID)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to get vrf with id " + strconv.Itoa(vrfID),
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	} else if vrfName != "" {
+		log.Printf("[DEBUG] vrf name : %s", vrfName)
+		v, err = getVRFByName(c, vrfName)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to get vrf with name " + vrfName,
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	log.Printf("[DEBUG] vrf : %v", v)
+
+	_ = d.Set("name", v.Name)
+	_ = d.Set("route_distinguisher", v.RouteDistinguisher)
+	_ = d.Set("import_route_targets", v.ImportRouteTargets)
+	_ = d.Set("export_route_targets", v.ExportRouteTargets)
+	_ = d.Set("description", v.Description)
+	_ = d.Set("vrf_group_id", v.VRFGroupID)
+
+	d.SetId(strconv.Itoa(v.ID))
+
+	return diags
+}
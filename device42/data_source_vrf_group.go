@@ -41,6 +41,28 @@ func dataSourceVRFGroup() *schema.Resource {
 					Type: schema.TypeInt,
 				},
 			},
+			"building_names": &schema.Schema{
+				Description: "The `building_names` of the VRF group.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"notes": &schema.Schema{
+				Description: "`notes` for the VRF group.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"tags": &schema.Schema{
+				Description: "The `tags` for the VRF group.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"custom_fields": customFieldsDataSourceSchema(),
 		},
 	}
 }
@@ -84,24 +106,34 @@ func dataSourceVRFGroupRead(ctx context.Context, d *schema.ResourceData, m inter
 
 	c.WriteToDebugLog(fmt.Sprintf("%v", vrfGroup))
 
-	buildings := make([]int, len(d.Get("building_ids").([]interface{})))
+	// the device42 client doesn't decode every field of a vrf group
+	group, err := getVRFGroupByID(c, vrfGroup.ID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get vrf group with id " + strconv.Itoa(vrfGroup.ID),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
 
-	for i, v := range d.Get("building_ids").([]interface{}) {
-		b, err := c.GetBuildingByID(v.(int))
-		if err != nil {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  "unable to get building with id " + strconv.Itoa(v.(int)),
-				Detail:   err.Error(),
-			})
-			return diags
-		}
-		buildings[i] = (*b).BuildingID
+	buildingIDs, err := getBuildingIDsByName(c, group.Buildings)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get buildings for vrf group with id " + strconv.Itoa(vrfGroup.ID),
+			Detail:   err.Error(),
+		})
+		return diags
 	}
 
-	_ = d.Set("name", vrfGroup.Name)
-	_ = d.Set("description", vrfGroup.Description)
-	_ = d.Set("building_ids", buildings)
+	_ = d.Set("name", group.Name)
+	_ = d.Set("description", group.Description)
+	_ = d.Set("building_ids", buildingIDs)
+	_ = d.Set("building_names", group.Buildings)
+	_ = d.Set("notes", group.Notes)
+	_ = d.Set("tags", group.Tags)
+	_ = d.Set("custom_fields", flattenCustomFields(group.CustomFields))
 
 	d.SetId(strconv.Itoa(vrfGroup.ID))
 
@@ -2,6 +2,7 @@ package device42
 
 import (
 	"context"
+	"net/url"
 
 	device42 "github.com/chopnico/device42-go"
 
@@ -14,6 +15,10 @@ func dataSourceVRFGroups() *schema.Resource {
 		Description: "`device42_vrf_groups` can be used to retrieve all VRF groups.",
 		ReadContext: dataSourceVRFGroupsRead,
 		Schema: map[string]*schema.Schema{
+			"max_results": maxResultsSchema(),
+			"filter":      filterSchema(),
+			"sort_by":     sortBySchema(),
+			"sort_order":  sortOrderSchema(),
 			"vrf_groups": &schema.Schema{
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -56,7 +61,20 @@ func dataSourceVRFGroupsRead(ctx context.Context, d *schema.ResourceData, m inte
 
 	var diags diag.Diagnostics
 
-	vrfGroups, err := c.GetVRFGroups()
+	filters, err := expandFilters(d, dataSourceVRFGroups().Schema["vrf_groups"].Elem.(*schema.Resource))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "invalid filter",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	// the vrf group endpoint doesn't take any filters, so they're all
+	// evaluated here
+	vrfGroups := make([]device42.VRFGroup, 0)
+	err = apiGetList(c, "/vrfgroup/", url.Values{}, "vrfgroup", fetchMax(d, filters), &vrfGroups)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -66,7 +84,9 @@ func dataSourceVRFGroupsRead(ctx context.Context, d *schema.ResourceData, m inte
 		return diags
 	}
 
-	vgs := flattenVRFGroupsData(vrfGroups)
+	vgs := applyFilters(flattenVRFGroupsData(&vrfGroups), filters)
+	sortItems(vgs, d.Get("sort_by").(string), d.Get("sort_order").(string))
+	vgs = limitItems(vgs, d.Get("max_results").(int))
 	if err := d.Set("vrf_groups", vgs); err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -76,14 +96,12 @@ func dataSourceVRFGroupsRead(ctx context.Context, d *schema.ResourceData, m inte
 		return diags
 	}
 
-	ids := make([]int, len((*vrfGroups)))
-	for _, i := range *vrfGroups {
-		ids = append(ids, i.ID)
+	ids := make([]int, 0, len(vgs))
+	for _, i := range vgs {
+		ids = append(ids, i.(map[string]interface{})["id"].(int))
 	}
 
-	checksum := idsChecksum(ids)
-
-	d.SetId(checksum)
+	d.SetId(listDataSourceID(d, dataSourceVRFGroups(), ids))
 
 	return diags
 }
@@ -0,0 +1,214 @@
+package device42
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"time"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceWarranties() *schema.Resource {
+	return &schema.Resource{
+		Description: "`device42_warranties` data source can be used to retrieve the warranties, or other contracts, that expire before a date.",
+		ReadContext: dataSourceWarrantiesRead,
+		Schema: map[string]*schema.Schema{
+			"expires_before": &schema.Schema{
+				Description:  "Only contracts with an end date before this date. (YYYY-MM-DD)",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateDate,
+			},
+			"expires_after": &schema.Schema{
+				Description:  "Only contracts with an end date on or after this date, to leave out contracts that have already expired. (YYYY-MM-DD)",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDate,
+			},
+			"contract_type": &schema.Schema{
+				Description:  "The `contract_type` of the contracts. (warranty, maintenance, support, lease or other)",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "warranty",
+				ValidateFunc: validation.StringInSlice(contractTypes, false),
+			},
+			"device_id": &schema.Schema{
+				Description: "Only contracts that cover the device with this `device_id`.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"warranties": &schema.Schema{
+				Description: "The contracts that match, by end date.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Description: "The `id` of the contract, as used by `device42_contract`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"purchase_id": &schema.Schema{
+							Description: "The id of the purchase the contract is part of.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"order_number": &schema.Schema{
+							Description: "The order number of the purchase.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"vendor": &schema.Schema{
+							Description: "The name of the vendor of the purchase.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"line_number": &schema.Schema{
+							Description: "The line number of the contract in the purchase.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"contract_type": &schema.Schema{
+							Description: "The type of the contract.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"start_date": &schema.Schema{
+							Description: "The start date of the contract.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"end_date": &schema.Schema{
+							Description: "The end date of the contract.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": &schema.Schema{
+							Description: "The description of the contract.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"device_ids": &schema.Schema{
+							Description: "The ids of the devices the contract covers.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+						"asset_ids": &schema.Schema{
+							Description: "The ids of the assets the contract covers.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// get the contracts expiring before a date
+func dataSourceWarrantiesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	purchases, err := getPurchases(c, url.Values{})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get a list of purchases",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	warranties, ids := filterWarranties(purchases,
+		d.Get("expires_before").(string),
+		d.Get("expires_after").(string),
+		d.Get("contract_type").(string),
+		d.Get("device_id").(int),
+	)
+
+	err = d.Set("warranties", warranties)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to set warranties",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(listDataSourceID(d, dataSourceWarranties(), ids))
+
+	return diags
+}
+
+// filterWarranties will return the contracts of purchases that match, sorted
+// by end date, and the ids of their purchases. contracts without a valid end
+// date never match.
+func filterWarranties(purchases []purchase, before, after, contractType string, deviceID int) ([]interface{}, []int) {
+	beforeDate, _ := time.Parse(dateFormat, before)
+	afterDate, _ := time.Parse(dateFormat, after)
+
+	type match struct {
+		end time.Time
+		w   map[string]interface{}
+	}
+	matches := make([]match, 0)
+	ids := make([]int, 0)
+
+	for _, p := range purchases {
+		for _, i := range p.LineItems {
+			if i.Type != "contract" || i.ContractType != contractType {
+				continue
+			}
+			if deviceID != 0 && !intInSlice(deviceID, i.DeviceIDs) {
+				continue
+			}
+
+			end, err := time.Parse(dateFormat, i.EndDate)
+			if err != nil || !end.Before(beforeDate) {
+				continue
+			}
+			if after != "" && end.Before(afterDate) {
+				continue
+			}
+
+			matches = append(matches, match{end: end, w: map[string]interface{}{
+				"id":            fmt.Sprintf("%d:%d", p.ID, i.Number),
+				"purchase_id":   p.ID,
+				"order_number":  p.OrderNumber,
+				"vendor":        p.Vendor,
+				"line_number":   i.Number,
+				"contract_type": i.ContractType,
+				"start_date":    i.StartDate,
+				"end_date":      i.EndDate,
+				"description":   i.Description,
+				"device_ids":    i.DeviceIDs,
+				"asset_ids":     i.AssetIDs,
+			}})
+			ids = append(ids, p.ID)
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].end.Before(matches[j].end) })
+
+	warranties := make([]interface{}, len(matches))
+	for n, i := range matches {
+		warranties[n] = i.w
+	}
+
+	return warranties, ids
+}
@@ -0,0 +1,244 @@
+package device42
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// listFilter type
+type listFilter struct {
+	Name   string
+	Values []string
+	Regex  bool
+}
+
+// filterSchema is the `filter` block of list data sources
+func filterSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Only include items where the attribute `name` matches one of `values`.",
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": &schema.Schema{
+					Description: "The `name` of the attribute to filter on.",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				"values": &schema.Schema{
+					Description: "The `values` to match. Any one of them matching is enough.",
+					Type:        schema.TypeList,
+					Required:    true,
+					MinItems:    1,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"regex": &schema.Schema{
+					Description: "Treat `values` as regular expressions.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+				},
+			},
+		},
+	}
+}
+
+// sortBySchema is the `sort_by` argument of list data sources
+func sortBySchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "The attribute to sort by. Sorting is done by the provider, not the appliance, so every matching item is read before `max_results` is applied.",
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+}
+
+// sortOrderSchema is the `sort_order` argument of list data sources
+func sortOrderSchema() *schema.Schema {
+	return &schema.Schema{
+		Description:  "The order to sort in. (asc or desc)",
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "asc",
+		ValidateFunc: validation.StringInSlice([]string{"asc", "desc"}, false),
+	}
+}
+
+// expandFilters will read the `filter` blocks, making sure each one names an
+// attribute of elem
+func expandFilters(d *schema.ResourceData, elem *schema.Resource) ([]listFilter, error) {
+	filters := make([]listFilter, 0)
+	for _, i := range d.Get("filter").([]interface{}) {
+		f := i.(map[string]interface{})
+		filter := listFilter{
+			Name:   f["name"].(string),
+			Values: interfaceSliceToStringSlice(f["values"].([]interface{})),
+			Regex:  f["regex"].(bool),
+		}
+		if _, ok := elem.Schema[filter.Name]; !ok {
+			return nil, fmt.Errorf("unable to filter on %s, it is not an attribute", filter.Name)
+		}
+		if filter.Regex {
+			for _, v := range filter.Values {
+				if _, err := regexp.Compile(v); err != nil {
+					return nil, fmt.Errorf("invalid regex %s : %s", v, err.Error())
+				}
+			}
+		}
+		filters = append(filters, filter)
+	}
+
+	if sortBy := d.Get("sort_by").(string); sortBy != "" {
+		if _, ok := elem.Schema[sortBy]; !ok {
+			return nil, fmt.Errorf("unable to sort by %s, it is not an attribute", sortBy)
+		}
+	}
+
+	return filters, nil
+}
+
+// serverSideFilters will return the query parameters for filters the
+// appliance can evaluate. filters with regexes or several values are always
+// evaluated client side.
+func serverSideFilters(filters []listFilter, supported []string) url.Values {
+	q := url.Values{}
+	for _, f := range filters {
+		if f.Regex || len(f.Values) != 1 || !stringInSlice(f.Name, supported) {
+			continue
+		}
+		q.Set(f.Name, f.Values[0])
+	}
+	return q
+}
+
+// applyFilters will return the flattened items matching every filter
+func applyFilters(items []interface{}, filters []listFilter) []interface{} {
+	if len(filters) == 0 {
+		return items
+	}
+
+	matches := make([]interface{}, 0, len(items))
+	for _, i := range items {
+		item := i.(map[string]interface{})
+		match := true
+		for _, f := range filters {
+			if !filterMatches(item[f.Name], f) {
+				match = false
+				break
+			}
+		}
+		if match {
+			matches = append(matches, i)
+		}
+	}
+	return matches
+}
+
+func filterMatches(v interface{}, f listFilter) bool {
+	var attrs []string
+	switch t := v.(type) {
+	case []string:
+		attrs = t
+	case []int:
+		attrs = make([]string, len(t))
+		for n, i := range t {
+			attrs[n] = fmt.Sprintf("%d", i)
+		}
+	case []interface{}:
+		attrs = interfaceSliceToStringSlice(t)
+	default:
+		attrs = []string{fmt.Sprintf("%v", t)}
+	}
+
+	for _, attr := range attrs {
+		for _, value := range f.Values {
+			if f.Regex {
+				if regexp.MustCompile(value).MatchString(attr) {
+					return true
+				}
+			} else if attr == value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// sortItems will sort flattened items by an attribute. numbers sort
+// numerically and addresses sort by address. the list endpoints have no
+// ordering parameter, so sorting is never done server side.
+func sortItems(items []interface{}, sortBy, sortOrder string) {
+	if sortBy == "" {
+		return
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		a := items[i].(map[string]interface{})[sortBy]
+		b := items[j].(map[string]interface{})[sortBy]
+		if sortOrder == "desc" {
+			a, b = b, a
+		}
+		return lessAttribute(a, b)
+	})
+}
+
+func lessAttribute(a, b interface{}) bool {
+	switch x := a.(type) {
+	case int:
+		if y, ok := b.(int); ok {
+			return x < y
+		}
+	case float64:
+		if y, ok := b.(float64); ok {
+			return x < y
+		}
+	case bool:
+		if y, ok := b.(bool); ok {
+			return !x && y
+		}
+	}
+
+	s, t := fmt.Sprintf("%v", a), fmt.Sprintf("%v", b)
+	if x, y := net.ParseIP(s), net.ParseIP(t); x != nil && y != nil {
+		return bytes.Compare(x.To16(), y.To16()) < 0
+	}
+	return strings.Compare(s, t) < 0
+}
+
+// maxResultsSchema is the `max_results` argument of list data sources
+func maxResultsSchema() *schema.Schema {
+	return &schema.Schema{
+		Description:  "The most results to return. 0 returns everything.",
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      0,
+		ValidateFunc: validation.IntAtLeast(0),
+	}
+}
+
+// fetchMax will return how many objects a list data source needs to read.
+// when results are filtered or sorted here, everything has to be read before
+// max_results can be applied.
+func fetchMax(d *schema.ResourceData, filters []listFilter) int {
+	if len(filters) > 0 || d.Get("sort_by").(string) != "" {
+		return 0
+	}
+	return d.Get("max_results").(int)
+}
+
+// limitItems will return no more than max items when max is greater than 0
+func limitItems(items []interface{}, max int) []interface{} {
+	if max > 0 && len(items) > max {
+		return items[:max]
+	}
+	return items
+}
@@ -0,0 +1,200 @@
+package device42
+
+import (
+	"reflect"
+	"testing"
+)
+
+func testItems() []interface{} {
+	return []interface{}{
+		map[string]interface{}{"id": 1, "name": "web-1", "address": "10.0.0.10", "tags": []interface{}{"prod", "web"}, "cost": 2.5, "enabled": true},
+		map[string]interface{}{"id": 2, "name": "db-1", "address": "10.0.0.9", "tags": []interface{}{"prod"}, "cost": 10.0, "enabled": false},
+		map[string]interface{}{"id": 10, "name": "web-2", "address": "10.0.0.100", "tags": []interface{}{}, "cost": 2.5, "enabled": true},
+		map[string]interface{}{"id": 3, "name": "Web-3", "address": "fd00::1", "tags": []interface{}{"dev", "web"}, "cost": 0.0, "enabled": false},
+	}
+}
+
+func itemIDs(items []interface{}) []int {
+	ids := make([]int, len(items))
+	for n, i := range items {
+		ids[n] = i.(map[string]interface{})["id"].(int)
+	}
+	return ids
+}
+
+func TestApplyFilters(t *testing.T) {
+	tests := []struct {
+		name    string
+		filters []listFilter
+		want    []int
+	}{
+		{
+			name: "no filters",
+			want: []int{1, 2, 10, 3},
+		},
+		{
+			name:    "exact value",
+			filters: []listFilter{{Name: "name", Values: []string{"web-1"}}},
+			want:    []int{1},
+		},
+		{
+			name:    "exact value is case sensitive",
+			filters: []listFilter{{Name: "name", Values: []string{"web-3"}}},
+			want:    []int{},
+		},
+		{
+			name:    "any value",
+			filters: []listFilter{{Name: "name", Values: []string{"web-1", "db-1"}}},
+			want:    []int{1, 2},
+		},
+		{
+			name:    "regex",
+			filters: []listFilter{{Name: "name", Values: []string{"^web-"}, Regex: true}},
+			want:    []int{1, 10},
+		},
+		{
+			name:    "any regex",
+			filters: []listFilter{{Name: "name", Values: []string{"(?i)^web-3$", "^db"}, Regex: true}},
+			want:    []int{2, 3},
+		},
+		{
+			name:    "number",
+			filters: []listFilter{{Name: "id", Values: []string{"10"}}},
+			want:    []int{10},
+		},
+		{
+			name:    "float",
+			filters: []listFilter{{Name: "cost", Values: []string{"2.5"}}},
+			want:    []int{1, 10},
+		},
+		{
+			name:    "bool",
+			filters: []listFilter{{Name: "enabled", Values: []string{"false"}}},
+			want:    []int{2, 3},
+		},
+		{
+			name:    "list contains a value",
+			filters: []listFilter{{Name: "tags", Values: []string{"web"}}},
+			want:    []int{1, 3},
+		},
+		{
+			name: "every filter has to match",
+			filters: []listFilter{
+				{Name: "tags", Values: []string{"prod"}},
+				{Name: "name", Values: []string{"^web"}, Regex: true},
+			},
+			want: []int{1},
+		},
+		{
+			name:    "nothing matches",
+			filters: []listFilter{{Name: "name", Values: []string{"mail"}}},
+			want:    []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := itemIDs(applyFilters(testItems(), tt.filters))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("applyFilters() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortItems(t *testing.T) {
+	tests := []struct {
+		name   string
+		sortBy string
+		order  string
+		want   []int
+	}{
+		{
+			name: "not sorted",
+			want: []int{1, 2, 10, 3},
+		},
+		{
+			name:   "numbers",
+			sortBy: "id",
+			order:  "asc",
+			want:   []int{1, 2, 3, 10},
+		},
+		{
+			name:   "numbers descending",
+			sortBy: "id",
+			order:  "desc",
+			want:   []int{10, 3, 2, 1},
+		},
+		{
+			name:   "strings",
+			sortBy: "name",
+			order:  "asc",
+			want:   []int{3, 2, 1, 10},
+		},
+		{
+			name:   "addresses",
+			sortBy: "address",
+			order:  "asc",
+			want:   []int{2, 1, 10, 3},
+		},
+		{
+			name:   "addresses descending",
+			sortBy: "address",
+			order:  "desc",
+			want:   []int{3, 10, 1, 2},
+		},
+		{
+			name:   "floats keep the order of ties",
+			sortBy: "cost",
+			order:  "asc",
+			want:   []int{3, 1, 10, 2},
+		},
+		{
+			name:   "bools",
+			sortBy: "enabled",
+			order:  "asc",
+			want:   []int{2, 3, 1, 10},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items := testItems()
+			sortItems(items, tt.sortBy, tt.order)
+			if got := itemIDs(items); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("sortItems() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServerSideFilters(t *testing.T) {
+	filters := []listFilter{
+		{Name: "name", Values: []string{"web-1"}},
+		{Name: "tags", Values: []string{"prod"}},
+		{Name: "address", Values: []string{"10.0.0.10", "10.0.0.9"}},
+		{Name: "vrf_group_id", Values: []string{"^1"}, Regex: true},
+	}
+
+	got := serverSideFilters(filters, []string{"name", "address", "vrf_group_id"})
+	if want := "name=web-1"; got.Encode() != want {
+		t.Errorf("serverSideFilters() = %s, want %s", got.Encode(), want)
+	}
+}
+
+func TestLimitItems(t *testing.T) {
+	tests := []struct {
+		max  int
+		want []int
+	}{
+		{max: 0, want: []int{1, 2, 10, 3}},
+		{max: 2, want: []int{1, 2}},
+		{max: 10, want: []int{1, 2, 10, 3}},
+	}
+
+	for _, tt := range tests {
+		if got := itemIDs(limitItems(testItems(), tt.max)); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("limitItems(%d) = %v, want %v", tt.max, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,223 @@
+package device42
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ipDNSSchema is the `dns` block of an ip resource
+func ipDNSSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Forward and reverse `dns` records to manage along with the IP.",
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"hostname": &schema.Schema{
+					Description:  "The `hostname` of the IP. It is qualified with `zone` unless it already ends with it.",
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateDNSName,
+				},
+				"zone": &schema.Schema{
+					Description:  "The forward `zone` to create the A or AAAA record in.",
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateDNSName,
+				},
+				"create_ptr": &schema.Schema{
+					Description: "Create a PTR record in the reverse zone of the IP.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+				},
+				"fqdn": &schema.Schema{
+					Description: "The fully qualified name of the IP.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"record_id": &schema.Schema{
+					Description: "The id of the A or AAAA record.",
+					Type:        schema.TypeInt,
+					Computed:    true,
+				},
+				"ptr_zone": &schema.Schema{
+					Description: "The reverse zone the PTR record was created in.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"ptr_record_id": &schema.Schema{
+					Description: "The id of the PTR record.",
+					Type:        schema.TypeInt,
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+// setIPDNSRecords will create the dns records of an ip. when the `dns` block
+// or the address changed, the records created before are replaced.
+func setIPDNSRecords(c *device42.API, d *schema.ResourceData, address string) error {
+	oldAddress, _ := d.GetChange("address")
+	if !d.IsNewResource() && !d.HasChange("dns") && oldAddress.(string) == address {
+		return nil
+	}
+
+	old, _ := d.GetChange("dns")
+	err := deleteDNSRecordsOfBlock(c, old.([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	dns := d.Get("dns").([]interface{})
+	if len(dns) == 0 || dns[0] == nil {
+		return d.Set("dns", []interface{}{})
+	}
+	block := dns[0].(map[string]interface{})
+
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return fmt.Errorf("%s is not an ip address", address)
+	}
+
+	zone := strings.TrimSuffix(block["zone"].(string), ".")
+	fqdn := strings.TrimSuffix(block["hostname"].(string), ".")
+	if fqdn != zone && !strings.HasSuffix(fqdn, "."+zone) {
+		fqdn = fqdn + "." + zone
+	}
+
+	recordType := "A"
+	if ip.To4() == nil {
+		recordType = "AAAA"
+	}
+
+	// find the reverse zone first, so a missing zone doesn't leave a
+	// forward record behind
+	ptrName, ptrZone := "", ""
+	if block["create_ptr"].(bool) {
+		ptrName = reverseDNSName(ip)
+		ptrZone, err = getReverseDNSZone(c, ptrName)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Println(fmt.Sprintf("[DEBUG] dns record : %s %s %s", fqdn, recordType, address))
+
+	recordID, err := createDNSRecord(c, &dnsRecord{
+		Zone:    zone,
+		Type:    recordType,
+		Name:    fqdn,
+		Content: ip.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to create %s record for %s : %s", recordType, fqdn, err.Error())
+	}
+
+	result := map[string]interface{}{
+		"hostname":      block["hostname"],
+		"zone":          block["zone"],
+		"create_ptr":    block["create_ptr"],
+		"fqdn":          fqdn,
+		"record_id":     recordID,
+		"ptr_zone":      "",
+		"ptr_record_id": 0,
+	}
+
+	if ptrZone != "" {
+		log.Println(fmt.Sprintf("[DEBUG] dns record : %s PTR %s", ptrName, fqdn))
+
+		ptrRecordID, err := createDNSRecord(c, &dnsRecord{
+			Zone:    ptrZone,
+			Type:    "PTR",
+			Name:    ptrName,
+			Content: fqdn,
+		})
+		if err != nil {
+			_ = d.Set("dns", []interface{}{result})
+			return fmt.Errorf("unable to create PTR record for %s : %s", address, err.Error())
+		}
+
+		result["ptr_zone"] = ptrZone
+		result["ptr_record_id"] = ptrRecordID
+	}
+
+	return d.Set("dns", []interface{}{result})
+}
+
+// deleteIPDNSRecords will delete the dns records created for an ip
+func deleteIPDNSRecords(c *device42.API, d *schema.ResourceData) error {
+	return deleteDNSRecordsOfBlock(c, d.Get("dns").([]interface{}))
+}
+
+func deleteDNSRecordsOfBlock(c *device42.API, dns []interface{}) error {
+	if len(dns) == 0 || dns[0] == nil {
+		return nil
+	}
+	block := dns[0].(map[string]interface{})
+
+	for _, k := range []string{"record_id", "ptr_record_id"} {
+		id, _ := block[k].(int)
+		if id == 0 {
+			continue
+		}
+		// the record may already be gone, any other error stops the delete
+		// so the record isn't left behind
+		if _, err := getDNSRecordByID(c, id); errors.Is(err, errDNSRecordNotFound) {
+			continue
+		} else if err != nil {
+			return fmt.Errorf("unable to read dns record with id %d : %s", id, err.Error())
+		}
+		if err := apiDelete(c, "/dns/records/"+strconv.Itoa(id)+"/"); err != nil {
+			return fmt.Errorf("unable to delete dns record with id %d : %s", id, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// reverseDNSName will return the name of the PTR record of an ip
+func reverseDNSName(ip net.IP) string {
+	if ip4 := ip.To4(); ip4 != nil {
+		return fmt.Sprintf("%d.%d.%d.%d.in-addr.arpa", ip4[3], ip4[2], ip4[1], ip4[0])
+	}
+
+	ip6 := ip.To16()
+	nibbles := make([]string, 0, 32)
+	for i := len(ip6) - 1; i >= 0; i-- {
+		nibbles = append(nibbles, strconv.FormatUint(uint64(ip6[i]&0x0f), 16), strconv.FormatUint(uint64(ip6[i]>>4), 16))
+	}
+	return strings.Join(nibbles, ".") + ".ip6.arpa"
+}
+
+// getReverseDNSZone will return the most specific zone a PTR record belongs in
+func getReverseDNSZone(c *device42.API, ptrName string) (string, error) {
+	zones, err := getDNSZones(c, url.Values{})
+	if err != nil {
+		return "", err
+	}
+
+	zone := ""
+	for _, z := range zones {
+		name := strings.TrimSuffix(z.Name, ".")
+		if strings.HasSuffix(ptrName, "."+name) && len(name) > len(zone) {
+			zone = name
+		}
+	}
+	if zone == "" {
+		return "", fmt.Errorf("could not find a reverse dns zone for %s", ptrName)
+	}
+
+	return zone, nil
+}
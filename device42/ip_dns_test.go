@@ -0,0 +1,61 @@
+package device42
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestDeleteDNSRecordsOfBlock(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		records []dnsRecord
+		deleted bool
+		wantErr bool
+	}{
+		{
+			name:    "record is deleted",
+			records: []dnsRecord{{ID: 7}},
+			deleted: true,
+		},
+		{
+			name:    "record is already gone",
+			records: []dnsRecord{},
+		},
+		{
+			name:    "lookup fails",
+			status:  http.StatusInternalServerError,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deleted := false
+			h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodDelete && r.URL.Path == "/api/1.0/dns/records/7/":
+					deleted = true
+					_ = json.NewEncoder(w).Encode(map[string]interface{}{})
+				case r.Method == http.MethodGet && r.URL.Path == "/api/1.0/dns/records/" && tt.status == 0:
+					_ = json.NewEncoder(w).Encode(map[string]interface{}{
+						"dns_records": tt.records,
+						"total_count": len(tt.records),
+					})
+				default:
+					w.WriteHeader(http.StatusInternalServerError)
+				}
+			})
+			c := newTestAPI(t, h)
+
+			err := deleteDNSRecordsOfBlock(c, []interface{}{map[string]interface{}{"record_id": 7}})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("deleteDNSRecordsOfBlock() error = %v, want error %v", err, tt.wantErr)
+			}
+			if deleted != tt.deleted {
+				t.Errorf("deleteDNSRecordsOfBlock() deleted = %v, want %v", deleted, tt.deleted)
+			}
+		})
+	}
+}
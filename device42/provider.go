@@ -45,23 +45,60 @@ func Provider() *schema.Provider {
 			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"device42_vrf_group":      resourceVRFGroup(),
-			"device42_building":       resourceBuilding(),
-			"device42_subnet":         resourceSubnet(),
-			"device42_vlan":           resourceVLAN(),
-			"device42_dynamic_subnet": resourceDynamicSubnet(),
-			"device42_dynamic_ip":     resourceDynamicIP(),
-			"device42_ip":             resourceIP(),
+			"device42_vrf_group":               resourceVRFGroup(),
+			"device42_building":                resourceBuilding(),
+			"device42_subnet":                  resourceSubnet(),
+			"device42_vlan":                    resourceVLAN(),
+			"device42_dynamic_subnet":          resourceDynamicSubnet(),
+			"device42_dynamic_vlan":            resourceDynamicVLAN(),
+			"device42_dynamic_ip":              resourceDynamicIP(),
+			"device42_ip":                      resourceIP(),
+			"device42_custom_field_definition": resourceCustomFieldDefinition(),
+			"device42_service_level":           resourceServiceLevel(),
+			"device42_object_category":         resourceObjectCategory(),
+			"device42_vrf":                     resourceVRF(),
+			"device42_dns_zone":                resourceDNSZone(),
+			"device42_dns_record":              resourceDNSRecord(),
+			"device42_ip_nat":                  resourceIPNAT(),
+			"device42_mac_address":             resourceMACAddress(),
+			"device42_switch_port":             resourceSwitchPort(),
+			"device42_patch_panel":             resourcePatchPanel(),
+			"device42_cable":                   resourceCable(),
+			"device42_pdu":                     resourcePDU(),
+			"device42_power_connection":        resourcePowerConnection(),
+			"device42_vendor":                  resourceVendor(),
+			"device42_hardware_model":          resourceHardwareModel(),
+			"device42_asset":                   resourceAsset(),
+			"device42_purchase":                resourcePurchase(),
+			"device42_contract":                resourceContract(),
+			"device42_business_application":    resourceBusinessApplication(),
+			"device42_application_component":   resourceApplicationComponent(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"device42_vrf_groups": dataSourceVRFGroups(),
-			"device42_vrf_group":  dataSourceVRFGroup(),
-			"device42_building":   dataSourceBuilding(),
-			"device42_buildings":  dataSourceBuildings(),
-			"device42_subnet":     dataSourceSubnet(),
-			"device42_subnets":    dataSourceSubnets(),
-			"device42_vlan":       dataSourceVLAN(),
-			"device42_ip":         dataSourceIP(),
+			"device42_vrf_groups":        dataSourceVRFGroups(),
+			"device42_vrf_group":         dataSourceVRFGroup(),
+			"device42_building":          dataSourceBuilding(),
+			"device42_buildings":         dataSourceBuildings(),
+			"device42_subnet":            dataSourceSubnet(),
+			"device42_subnets":           dataSourceSubnets(),
+			"device42_subnet_tree":       dataSourceSubnetTree(),
+			"device42_subnet_containing": dataSourceSubnetContaining(),
+			"device42_vlan":              dataSourceVLAN(),
+			"device42_vlans":             dataSourceVLANs(),
+			"device42_ip":                dataSourceIP(),
+			"device42_ips":               dataSourceIPs(),
+			"device42_service_level":     dataSourceServiceLevel(),
+			"device42_object_category":   dataSourceObjectCategory(),
+			"device42_vrf":               dataSourceVRF(),
+			"device42_dns_zone":          dataSourceDNSZone(),
+			"device42_dns_record":        dataSourceDNSRecord(),
+			"device42_ip_nats":           dataSourceIPNATs(),
+			"device42_cable_path":        dataSourceCablePath(),
+			"device42_rack_power":        dataSourceRackPower(),
+			"device42_vendor":            dataSourceVendor(),
+			"device42_hardware_model":    dataSourceHardwareModel(),
+			"device42_warranties":        dataSourceWarranties(),
+			"device42_affinity_group":    dataSourceAffinityGroup(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
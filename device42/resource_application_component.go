@@ -0,0 +1,260 @@
+package device42
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// applicationComponent type
+type applicationComponent struct {
+	ID                    int    `json:"id"`
+	Name                  string `json:"name"`
+	BusinessApplicationID int    `json:"business_app_id"`
+	DeviceID              int    `json:"device_id"`
+	Owner                 string `json:"owner"`
+	Criticality           string `json:"criticality"`
+	Description           string `json:"description"`
+	IPIDs                 []int  `json:"ip_ids"`
+	DependsOnIDs          []int  `json:"depends_on_ids"`
+}
+
+func resourceApplicationComponent() *schema.Resource {
+	return &schema.Resource{
+		Description:   "`device42_application_component` resource can be used to create, update or delete an application component.",
+		CreateContext: resourceApplicationComponentCreate,
+		ReadContext:   resourceApplicationComponentRead,
+		UpdateContext: resourceApplicationComponentUpdate,
+		DeleteContext: resourceApplicationComponentDelete,
+		Schema: map[string]*schema.Schema{
+			"last_updated": &schema.Schema{
+				Description: "The last time this resource was updated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": &schema.Schema{
+				Description: "The `name` of the application component.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"business_application_id": &schema.Schema{
+				Description: "The `business_application_id` of the business application the component is part of.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"device_id": &schema.Schema{
+				Description: "The `device_id` of the device the component runs on.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"owner": &schema.Schema{
+				Description: "The `owner` of the application component.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"criticality": &schema.Schema{
+				Description:  "The `criticality` of the application component. (critical, high, medium or low)",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(applicationCriticalities, false),
+			},
+			"description": &schema.Schema{
+				Description: "The `description` of the application component.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"ip_ids": &schema.Schema{
+				Description: "The ids of the IPs the component listens on, such as those of `device42_dynamic_ip`.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"depends_on_ids": &schema.Schema{
+				Description: "The ids of the application components this component depends on.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceApplicationComponentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+
+	log.Println(fmt.Sprintf("[DEBUG] application component : %s", name))
+
+	id, err := apiPost(c, "/appcomps/", applicationComponentParameters(d))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create application component with name " + name,
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(id))
+
+	return resourceApplicationComponentRead(ctx, d, m)
+}
+
+func resourceApplicationComponentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read id",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	if intInSlice(id, interfaceSliceToIntSlice(d.Get("depends_on_ids").(*schema.Set).List())) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to update application component with id " + d.Id(),
+			Detail:   "an application component can't depend on itself",
+		})
+		return diags
+	}
+
+	_, err = apiPut(c, "/appcomps/"+d.Id()+"/", applicationComponentParameters(d))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to update application component with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return resourceApplicationComponentRead(ctx, d, m)
+}
+
+func resourceApplicationComponentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read id",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	component, err := getApplicationComponentByID(c, id)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get application component with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	log.Println(fmt.Sprintf("[DEBUG] application component : %v", component))
+
+	_ = d.Set("name", component.Name)
+	_ = d.Set("business_application_id", component.BusinessApplicationID)
+	_ = d.Set("device_id", component.DeviceID)
+	_ = d.Set("owner", component.Owner)
+	_ = d.Set("criticality", strings.ToLower(component.Criticality))
+	_ = d.Set("description", component.Description)
+	_ = d.Set("ip_ids", component.IPIDs)
+	_ = d.Set("depends_on_ids", component.DependsOnIDs)
+
+	return diags
+}
+
+// delete application component
+func resourceApplicationComponentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+	var diags diag.Diagnostics
+
+	err := apiDelete(c, "/appcomps/"+d.Id()+"/")
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete application component with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func applicationComponentParameters(d *schema.ResourceData) url.Values {
+	p := url.Values{}
+	p.Set("name", d.Get("name").(string))
+	p.Set("owner", d.Get("owner").(string))
+	p.Set("criticality", d.Get("criticality").(string))
+	p.Set("description", d.Get("description").(string))
+	p.Set("ip_ids", intsToCommaString(interfaceSliceToIntSlice(d.Get("ip_ids").(*schema.Set).List())))
+	p.Set("depends_on_ids", intsToCommaString(interfaceSliceToIntSlice(d.Get("depends_on_ids").(*schema.Set).List())))
+	if v := d.Get("business_application_id").(int); v != 0 {
+		p.Set("business_app_id", strconv.Itoa(v))
+	}
+	if v := d.Get("device_id").(int); v != 0 {
+		p.Set("device_id", strconv.Itoa(v))
+	}
+
+	return p
+}
+
+// getApplicationComponents will return a list of application components matching q
+func getApplicationComponents(c *device42.API, q url.Values) ([]applicationComponent, error) {
+	components := make([]applicationComponent, 0)
+	if err := apiGetList(c, "/appcomps/", q, "appcomps", 0, &components); err != nil {
+		return nil, err
+	}
+
+	return components, nil
+}
+
+// getApplicationComponentByID will return an application component by id
+func getApplicationComponentByID(c *device42.API, id int) (*applicationComponent, error) {
+	components, err := getApplicationComponents(c, url.Values{"id": {strconv.Itoa(id)}})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range components {
+		if i.ID == id {
+			return &i, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find application component with id %d", id)
+}
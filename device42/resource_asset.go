@@ -0,0 +1,244 @@
+package device42
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// asset type
+type asset struct {
+	ID           int    `json:"asset_id"`
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	SerialNumber string `json:"serial_no"`
+	AssetNumber  string `json:"asset_no"`
+	Vendor       string `json:"vendor"`
+	Notes        string `json:"notes"`
+}
+
+func resourceAsset() *schema.Resource {
+	return &schema.Resource{
+		Description:   "`device42_asset` resource can be used to create, update or delete an asset.",
+		CreateContext: resourceAssetCreate,
+		ReadContext:   resourceAssetRead,
+		UpdateContext: resourceAssetUpdate,
+		DeleteContext: resourceAssetDelete,
+		Schema: map[string]*schema.Schema{
+			"last_updated": &schema.Schema{
+				Description: "The last time this resource was updated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"type": &schema.Schema{
+				Description: "The `type` of the asset. (e.g., UPS, Air Conditioner or Other)",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"name": &schema.Schema{
+				Description: "The `name` of the asset.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"serial_number": &schema.Schema{
+				Description: "The `serial_number` of the asset.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"asset_number": &schema.Schema{
+				Description: "The `asset_number` of the asset.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"vendor_id": &schema.Schema{
+				Description: "The `vendor_id` of the vendor of the asset.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"notes": &schema.Schema{
+				Description: "`notes` for the asset.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceAssetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	p, err := assetParameters(c, d)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get vendor with id " + strconv.Itoa(d.Get("vendor_id").(int)),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	log.Println(fmt.Sprintf("[DEBUG] asset : %s", p.Encode()))
+
+	id, err := apiPost(c, "/assets/", p)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create asset of type " + d.Get("type").(string),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(id))
+
+	return resourceAssetRead(ctx, d, m)
+}
+
+func resourceAssetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	p, err := assetParameters(c, d)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get vendor with id " + strconv.Itoa(d.Get("vendor_id").(int)),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	_, err = apiPut(c, "/assets/"+d.Id()+"/", p)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to update asset with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return resourceAssetRead(ctx, d, m)
+}
+
+func resourceAssetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read id",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	a, err := getAssetByID(c, id)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get asset with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	log.Println(fmt.Sprintf("[DEBUG] asset : %v", a))
+
+	vendorID, err := vendorIDByName(c, a.Vendor)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get vendor with name " + a.Vendor,
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	_ = d.Set("type", a.Type)
+	_ = d.Set("name", a.Name)
+	_ = d.Set("serial_number", a.SerialNumber)
+	_ = d.Set("asset_number", a.AssetNumber)
+	_ = d.Set("vendor_id", vendorID)
+	_ = d.Set("notes", a.Notes)
+
+	return diags
+}
+
+// delete asset
+func resourceAssetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+	var diags diag.Diagnostics
+
+	err := apiDelete(c, "/assets/"+d.Id()+"/")
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete asset with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func assetParameters(c *device42.API, d *schema.ResourceData) (url.Values, error) {
+	vendor, err := vendorNameByID(c, d.Get("vendor_id").(int))
+	if err != nil {
+		return nil, err
+	}
+
+	return url.Values{
+		"type":      {d.Get("type").(string)},
+		"name":      {d.Get("name").(string)},
+		"serial_no": {d.Get("serial_number").(string)},
+		"asset_no":  {d.Get("asset_number").(string)},
+		"vendor":    {vendor},
+		"notes":     {d.Get("notes").(string)},
+	}, nil
+}
+
+// getAssets will return a list of assets matching q
+func getAssets(c *device42.API, q url.Values) ([]asset, error) {
+	assets := make([]asset, 0)
+	if err := apiGetList(c, "/assets/", q, "assets", 0, &assets); err != nil {
+		return nil, err
+	}
+
+	return assets, nil
+}
+
+// getAssetByID will return an asset by id
+func getAssetByID(c *device42.API, id int) (*asset, error) {
+	assets, err := getAssets(c, url.Values{"asset_id": {strconv.Itoa(id)}})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range assets {
+		if i.ID == id {
+			return &i, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find asset with id %d", id)
+}
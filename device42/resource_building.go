@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/url"
 	"strconv"
 
 	"github.com/chopnico/device42-go"
@@ -40,6 +41,8 @@ func resourceBuilding() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"object_category": objectCategorySchema(),
+			"custom_fields":   customFieldsResourceSchema(),
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -74,6 +77,26 @@ func resourceBuildingSet(ctx context.Context, d *schema.ResourceData, m interfac
 
 	d.SetId(strconv.Itoa(building.BuildingID))
 
+	err = setObjectCategory(c, d, "POST", "/buildings/", url.Values{"name": {building.Name}})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to set object category for building with name " + building.Name,
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	err = setCustomFields(c, d, "building", url.Values{"name": {building.Name}})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to set custom fields for building with name " + building.Name,
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
 	resourceBuildingRead(ctx, d, m)
 
 	return diags
@@ -109,6 +132,18 @@ func resourceBuildingRead(ctx context.Context, d *schema.ResourceData, m interfa
 	_ = d.Set("name", building.Name)
 	_ = d.Set("address", building.Address)
 	_ = d.Set("notes", building.Notes)
+	_ = d.Set("custom_fields", flattenManagedCustomFields(customFieldsFromInterface(building.CustomFields), d))
+
+	category, err := getObjectCategoryName(c, "/buildings/", "buildings", "building_id", buildingID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get object category for building with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	_ = d.Set("object_category", category)
 
 	return diags
 }
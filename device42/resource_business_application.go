@@ -0,0 +1,222 @@
+package device42
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var applicationCriticalities = []string{"critical", "high", "medium", "low"}
+
+// businessApplication type
+type businessApplication struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	Owner        string `json:"owner"`
+	Criticality  string `json:"criticality"`
+	Description  string `json:"description"`
+	DeviceIDs    []int  `json:"device_ids"`
+	IPIDs        []int  `json:"ip_ids"`
+	DependsOnIDs []int  `json:"depends_on_ids"`
+}
+
+func resourceBusinessApplication() *schema.Resource {
+	return &schema.Resource{
+		Description:   "`device42_business_application` resource can be used to create, update or delete a business application.",
+		CreateContext: resourceBusinessApplicationSet,
+		ReadContext:   resourceBusinessApplicationRead,
+		UpdateContext: resourceBusinessApplicationSet,
+		DeleteContext: resourceBusinessApplicationDelete,
+		Schema: map[string]*schema.Schema{
+			"last_updated": &schema.Schema{
+				Description: "The last time this resource was updated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": &schema.Schema{
+				Description: "The `name` of the business application.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"owner": &schema.Schema{
+				Description: "The `owner` of the business application.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"criticality": &schema.Schema{
+				Description:  "The `criticality` of the business application. (critical, high, medium or low)",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(applicationCriticalities, false),
+			},
+			"description": &schema.Schema{
+				Description: "The `description` of the business application.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"device_ids": &schema.Schema{
+				Description: "The ids of the devices the business application runs on.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"ip_ids": &schema.Schema{
+				Description: "The ids of the IPs the business application uses, such as those of `device42_dynamic_ip`.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"depends_on_ids": &schema.Schema{
+				Description: "The ids of the business applications this business application depends on.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceBusinessApplicationSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+	dependsOn := interfaceSliceToIntSlice(d.Get("depends_on_ids").(*schema.Set).List())
+
+	log.Println(fmt.Sprintf("[DEBUG] business application : %s", name))
+
+	if id, err := strconv.Atoi(d.Id()); err == nil && intInSlice(id, dependsOn) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to update business application with id " + d.Id(),
+			Detail:   "a business application can't depend on itself",
+		})
+		return diags
+	}
+
+	id, err := apiPost(c, "/businessapps/", url.Values{
+		"name":           {name},
+		"owner":          {d.Get("owner").(string)},
+		"criticality":    {d.Get("criticality").(string)},
+		"description":    {d.Get("description").(string)},
+		"device_ids":     {intsToCommaString(interfaceSliceToIntSlice(d.Get("device_ids").(*schema.Set).List()))},
+		"ip_ids":         {intsToCommaString(interfaceSliceToIntSlice(d.Get("ip_ids").(*schema.Set).List()))},
+		"depends_on_ids": {intsToCommaString(dependsOn)},
+	})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create business application with name " + name,
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(id))
+
+	return resourceBusinessApplicationRead(ctx, d, m)
+}
+
+func resourceBusinessApplicationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read id",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	app, err := getBusinessApplicationByID(c, id)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get business application with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	log.Println(fmt.Sprintf("[DEBUG] business application : %v", app))
+
+	_ = d.Set("name", app.Name)
+	_ = d.Set("owner", app.Owner)
+	_ = d.Set("criticality", strings.ToLower(app.Criticality))
+	_ = d.Set("description", app.Description)
+	_ = d.Set("device_ids", app.DeviceIDs)
+	_ = d.Set("ip_ids", app.IPIDs)
+	_ = d.Set("depends_on_ids", app.DependsOnIDs)
+
+	return diags
+}
+
+// delete business application
+func resourceBusinessApplicationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+	var diags diag.Diagnostics
+
+	err := apiDelete(c, "/businessapps/"+d.Id()+"/")
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete business application with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// getBusinessApplications will return a list of business applications matching q
+func getBusinessApplications(c *device42.API, q url.Values) ([]businessApplication, error) {
+	apps := make([]businessApplication, 0)
+	if err := apiGetList(c, "/businessapps/", q, "business_applications", 0, &apps); err != nil {
+		return nil, err
+	}
+
+	return apps, nil
+}
+
+// getBusinessApplicationByID will return a business application by id
+func getBusinessApplicationByID(c *device42.API, id int) (*businessApplication, error) {
+	apps, err := getBusinessApplications(c, url.Values{"id": {strconv.Itoa(id)}})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range apps {
+		if i.ID == id {
+			return &i, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find business application with id %d", id)
+}
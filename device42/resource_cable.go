@@ -0,0 +1,394 @@
+package device42
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// cableEnd type. device ports and switch ports are both switch ports.
+type cableEnd struct {
+	PortType string `json:"port_type"`
+	PortID   int    `json:"port_id"`
+	Side     string `json:"side"`
+}
+
+// cable type
+type cable struct {
+	ID     int      `json:"id"`
+	A      cableEnd `json:"a_end"`
+	B      cableEnd `json:"b_end"`
+	Type   string   `json:"cable_type"`
+	Color  string   `json:"color"`
+	Label  string   `json:"label"`
+	Length float64  `json:"length"`
+}
+
+// cableEndSchema is an end of a cable
+func cableEndSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Description: description,
+		Type:        schema.TypeList,
+		Required:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"port_type": &schema.Schema{
+					Description:  "The `port_type` of the port. (switch_port or patch_panel_port) Ports of devices are switch ports.",
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringInSlice([]string{"switch_port", "patch_panel_port"}, false),
+				},
+				"port_id": &schema.Schema{
+					Description: "The `port_id` of the port.",
+					Type:        schema.TypeInt,
+					Required:    true,
+					ForceNew:    true,
+				},
+				"side": &schema.Schema{
+					Description:  "The `side` of a patch panel port. (front or back) Defaults to front.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringInSlice([]string{"front", "back"}, false),
+				},
+			},
+		},
+	}
+}
+
+func resourceCable() *schema.Resource {
+	return &schema.Resource{
+		Description:   "`device42_cable` resource can be used to connect two ports with a cable.",
+		CreateContext: resourceCableCreate,
+		ReadContext:   resourceCableRead,
+		UpdateContext: resourceCableUpdate,
+		DeleteContext: resourceCableDelete,
+		Schema: map[string]*schema.Schema{
+			"last_updated": &schema.Schema{
+				Description: "The last time this resource was updated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"a_end": cableEndSchema("The port at one end of the cable."),
+			"b_end": cableEndSchema("The port at the other end of the cable."),
+			"type": &schema.Schema{
+				Description: "The `type` of the cable. (e.g., Cat6 or OM4)",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"color": &schema.Schema{
+				Description: "The `color` of the cable.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"label": &schema.Schema{
+				Description: "The `label` of the cable.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"length": &schema.Schema{
+				Description:  "The `length` of the cable in meters.",
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceCableCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	a := expandCableEnd(d.Get("a_end").([]interface{}))
+	b := expandCableEnd(d.Get("b_end").([]interface{}))
+
+	if cableEndKey(a) == cableEndKey(b) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create cable",
+			Detail:   "both ends of the cable are " + cableEndKey(a),
+		})
+		return diags
+	}
+
+	for _, end := range []cableEnd{a, b} {
+		connected, _, err := cableAtPort(c, end)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to get the cables of " + cableEndKey(end),
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+		if connected != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to create cable",
+				Detail:   fmt.Sprintf("%s is already connected by cable with id %d", cableEndKey(end), connected.ID),
+			})
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	p := cableParameters(d)
+	for prefix, end := range map[string]cableEnd{"a_": a, "b_": b} {
+		p.Set(prefix+"port_type", end.PortType)
+		p.Set(prefix+"port_id", strconv.Itoa(end.PortID))
+		if end.PortType == "patch_panel_port" {
+			p.Set(prefix+"side", end.Side)
+		}
+	}
+
+	log.Println(fmt.Sprintf("[DEBUG] cable : %s", p.Encode()))
+
+	id, err := apiPost(c, "/cables/", p)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create cable from " + cableEndKey(a) + " to " + cableEndKey(b),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(id))
+
+	return resourceCableRead(ctx, d, m)
+}
+
+func resourceCableUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	p := cableParameters(d)
+
+	log.Println(fmt.Sprintf("[DEBUG] cable : %s", p.Encode()))
+
+	_, err := apiPut(c, "/cables/"+d.Id()+"/", p)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to update cable with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return resourceCableRead(ctx, d, m)
+}
+
+func resourceCableRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read id",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	cb, err := getCableByID(c, id)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get cable with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	log.Println(fmt.Sprintf("[DEBUG] cable : %v", cb))
+
+	_ = d.Set("a_end", flattenCableEnd(cb.A))
+	_ = d.Set("b_end", flattenCableEnd(cb.B))
+	_ = d.Set("type", cb.Type)
+	_ = d.Set("color", cb.Color)
+	_ = d.Set("label", cb.Label)
+	_ = d.Set("length", cb.Length)
+
+	return diags
+}
+
+// delete cable
+func resourceCableDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+	var diags diag.Diagnostics
+
+	err := apiDelete(c, "/cables/"+d.Id()+"/")
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete cable with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func cableParameters(d *schema.ResourceData) url.Values {
+	p := url.Values{}
+	p.Set("cable_type", d.Get("type").(string))
+	p.Set("color", d.Get("color").(string))
+	p.Set("label", d.Get("label").(string))
+	if v := d.Get("length").(float64); v != 0 {
+		p.Set("length", strconv.FormatFloat(v, 'f', -1, 64))
+	}
+	return p
+}
+
+func expandCableEnd(i []interface{}) cableEnd {
+	end := cableEnd{}
+	if len(i) == 0 || i[0] == nil {
+		return end
+	}
+
+	e := i[0].(map[string]interface{})
+	end.PortType = e["port_type"].(string)
+	end.PortID = e["port_id"].(int)
+	end.Side = e["side"].(string)
+
+	return normalizeCableEnd(end)
+}
+
+func flattenCableEnd(end cableEnd) []interface{} {
+	end = normalizeCableEnd(end)
+	return []interface{}{
+		map[string]interface{}{
+			"port_type": end.PortType,
+			"port_id":   end.PortID,
+			"side":      end.Side,
+		},
+	}
+}
+
+// normalizeCableEnd will default patch panel ports to their front side.
+// switch ports don't have sides.
+func normalizeCableEnd(end cableEnd) cableEnd {
+	switch end.PortType {
+	case "patch_panel_port":
+		if end.Side == "" {
+			end.Side = "front"
+		}
+	default:
+		end.Side = ""
+	}
+	return end
+}
+
+// cableEndKey identifies a port, and the side of a patch panel port
+func cableEndKey(end cableEnd) string {
+	end = normalizeCableEnd(end)
+	if end.Side != "" {
+		return fmt.Sprintf("%s %d (%s)", end.PortType, end.PortID, end.Side)
+	}
+	return fmt.Sprintf("%s %d", end.PortType, end.PortID)
+}
+
+// cableAt will return the cable connected to a port along with the end at
+// the other side of it, or nil when the port isn't connected
+func cableAt(cables []cable, end cableEnd) (*cable, cableEnd) {
+	key := cableEndKey(end)
+	for i := range cables {
+		if cableEndKey(cables[i].A) == key {
+			return &cables[i], normalizeCableEnd(cables[i].B)
+		}
+		if cableEndKey(cables[i].B) == key {
+			return &cables[i], normalizeCableEnd(cables[i].A)
+		}
+	}
+	return nil, cableEnd{}
+}
+
+// cableAtPort will look up the cable connected to a port along with the end
+// at the other side of it, or nil when the port isn't connected
+func cableAtPort(c *device42.API, end cableEnd) (*cable, cableEnd, error) {
+	cables, err := getCablesOfPort(c, end)
+	if err != nil {
+		return nil, cableEnd{}, err
+	}
+
+	cb, to := cableAt(cables, end)
+	return cb, to, nil
+}
+
+// getCablesOfPort will return the cables with either end on a port. device42
+// filters one end at a time, so both ends are queried. the side of a patch
+// panel port isn't filtered on, cableAt picks the cable on the right side.
+func getCablesOfPort(c *device42.API, end cableEnd) ([]cable, error) {
+	cables := make([]cable, 0)
+	seen := make(map[int]bool)
+	for _, prefix := range []string{"a_", "b_"} {
+		q := url.Values{}
+		q.Set(prefix+"port_type", end.PortType)
+		q.Set(prefix+"port_id", strconv.Itoa(end.PortID))
+
+		side, err := getCables(c, q)
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range side {
+			if !seen[i.ID] {
+				seen[i.ID] = true
+				cables = append(cables, i)
+			}
+		}
+	}
+
+	return cables, nil
+}
+
+// getCables will return a list of cables matching q
+func getCables(c *device42.API, q url.Values) ([]cable, error) {
+	cables := make([]cable, 0)
+	if err := apiGetList(c, "/cables/", q, "cables", 0, &cables); err != nil {
+		return nil, err
+	}
+
+	return cables, nil
+}
+
+// getCableByID will return a cable by id
+func getCableByID(c *device42.API, id int) (*cable, error) {
+	cables, err := getCables(c, url.Values{"id": {strconv.Itoa(id)}})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range cables {
+		if i.ID == id {
+			return &i, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find cable with id %d", id)
+}
@@ -0,0 +1,304 @@
+package device42
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var contractTypes = []string{"warranty", "maintenance", "support", "lease", "other"}
+
+func resourceContract() *schema.Resource {
+	return &schema.Resource{
+		Description:   "`device42_contract` resource can be used to create, update or delete a contract, such as a warranty, as a line item of a purchase. The id of the resource is `<purchase_id>:<line_number>`.",
+		CreateContext: resourceContractCreate,
+		ReadContext:   resourceContractRead,
+		UpdateContext: resourceContractUpdate,
+		DeleteContext: resourceContractDelete,
+		CustomizeDiff: resourceContractCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"last_updated": &schema.Schema{
+				Description: "The last time this resource was updated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"purchase_id": &schema.Schema{
+				Description: "The `purchase_id` of the purchase the contract is part of.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"line_number": &schema.Schema{
+				Description:  "The `line_number` of the contract in the purchase. It must not be used by a `line_item` of the purchase.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"contract_type": &schema.Schema{
+				Description:  "The `contract_type`. (warranty, maintenance, support, lease or other)",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "warranty",
+				ValidateFunc: validation.StringInSlice(contractTypes, false),
+			},
+			"start_date": &schema.Schema{
+				Description:  "The `start_date` of the contract. (YYYY-MM-DD)",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateDate,
+			},
+			"end_date": &schema.Schema{
+				Description:  "The `end_date` of the contract. (YYYY-MM-DD)",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateDate,
+			},
+			"cost": &schema.Schema{
+				Description:  "The `cost` of the contract.",
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			"description": &schema.Schema{
+				Description: "The `description` of the contract.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"device_ids": &schema.Schema{
+				Description: "The ids of the devices the contract covers.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"asset_ids": &schema.Schema{
+				Description: "The ids of the assets the contract covers.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// a contract can't end before it starts
+func resourceContractCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	start, err := time.Parse(dateFormat, d.Get("start_date").(string))
+	if err != nil {
+		return nil
+	}
+	end, err := time.Parse(dateFormat, d.Get("end_date").(string))
+	if err != nil {
+		return nil
+	}
+
+	if end.Before(start) {
+		return fmt.Errorf("end_date %s is before start_date %s", d.Get("end_date").(string), d.Get("start_date").(string))
+	}
+
+	return nil
+}
+
+func resourceContractCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	purchaseID := d.Get("purchase_id").(int)
+	number := d.Get("line_number").(int)
+
+	p, err := getPurchaseByID(c, purchaseID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("unable to get purchase with id %d", purchaseID),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	if purchaseLineItemByNumber(p, number) != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create contract",
+			Detail:   fmt.Sprintf("line %d of purchase with id %d is already used", number, purchaseID),
+		})
+		return diags
+	}
+
+	item := expandContract(d)
+
+	log.Println(fmt.Sprintf("[DEBUG] contract : %v", item))
+
+	if err := setPurchaseLineItem(c, p.OrderNumber, item); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("unable to create contract on line %d of purchase with id %d", number, purchaseID),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(fmt.Sprintf("%d:%d", purchaseID, number))
+
+	return resourceContractRead(ctx, d, m)
+}
+
+func resourceContractUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	p, err := getPurchaseByID(c, d.Get("purchase_id").(int))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get purchase of contract with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	if err := setPurchaseLineItem(c, p.OrderNumber, expandContract(d)); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to update contract with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return resourceContractRead(ctx, d, m)
+}
+
+func resourceContractRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	purchaseID, number, err := parseContractID(d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read id",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	p, err := getPurchaseByID(c, purchaseID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get purchase of contract with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	item := purchaseLineItemByNumber(p, number)
+	if item == nil || item.Type != "contract" {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get contract with id " + d.Id(),
+			Detail:   fmt.Sprintf("line %d of purchase with id %d is not a contract", number, purchaseID),
+		})
+		return diags
+	}
+
+	log.Println(fmt.Sprintf("[DEBUG] contract : %v", item))
+
+	_ = d.Set("purchase_id", purchaseID)
+	_ = d.Set("line_number", number)
+	_ = d.Set("contract_type", item.ContractType)
+	_ = d.Set("start_date", item.StartDate)
+	_ = d.Set("end_date", item.EndDate)
+	_ = d.Set("cost", item.Cost)
+	_ = d.Set("description", item.Description)
+	_ = d.Set("device_ids", item.DeviceIDs)
+	_ = d.Set("asset_ids", item.AssetIDs)
+
+	return diags
+}
+
+// delete contract
+func resourceContractDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+	var diags diag.Diagnostics
+
+	purchaseID, number, err := parseContractID(d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read id",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	err = deletePurchaseLineItem(c, purchaseID, number)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete contract with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func expandContract(d *schema.ResourceData) purchaseLineItem {
+	return purchaseLineItem{
+		Number:       d.Get("line_number").(int),
+		Type:         "contract",
+		Description:  d.Get("description").(string),
+		Quantity:     1,
+		Cost:         d.Get("cost").(float64),
+		DeviceIDs:    interfaceSliceToIntSlice(d.Get("device_ids").(*schema.Set).List()),
+		AssetIDs:     interfaceSliceToIntSlice(d.Get("asset_ids").(*schema.Set).List()),
+		ContractType: d.Get("contract_type").(string),
+		StartDate:    d.Get("start_date").(string),
+		EndDate:      d.Get("end_date").(string),
+	}
+}
+
+// parseContractID will return the purchase id and line number of a contract
+// id in the format <purchase_id>:<line_number>
+func parseContractID(id string) (int, int, error) {
+	var purchaseID, number int
+	if _, err := fmt.Sscanf(id, "%d:%d", &purchaseID, &number); err != nil {
+		return 0, 0, fmt.Errorf("expected id in the format <purchase_id>:<line_number>, got %s", id)
+	}
+
+	return purchaseID, number, nil
+}
+
+// purchaseLineItemByNumber will return a line item of a purchase by its line
+// number, or nil
+func purchaseLineItemByNumber(p *purchase, number int) *purchaseLineItem {
+	for _, i := range p.LineItems {
+		if i.Number == number {
+			return &i
+		}
+	}
+
+	return nil
+}
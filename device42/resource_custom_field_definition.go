@@ -0,0 +1,219 @@
+package device42
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// customFieldDefinition type
+type customFieldDefinition struct {
+	ID           int    `json:"id"`
+	ObjectType   string `json:"object_type"`
+	Key          string `json:"key"`
+	Type         string `json:"type"`
+	Mandatory    bool   `json:"mandatory"`
+	DefaultValue string `json:"default_value"`
+	Filterable   bool   `json:"filterable"`
+	RelatedModel string `json:"related_model"`
+	Notes        string `json:"notes"`
+}
+
+func resourceCustomFieldDefinition() *schema.Resource {
+	return &schema.Resource{
+		Description:   "`device42_custom_field_definition` resource can be used to create, update or delete the definition of a custom field.",
+		CreateContext: resourceCustomFieldDefinitionSet,
+		ReadContext:   resourceCustomFieldDefinitionRead,
+		UpdateContext: resourceCustomFieldDefinitionSet,
+		DeleteContext: resourceCustomFieldDefinitionDelete,
+		CustomizeDiff: resourceCustomFieldDefinitionCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"last_updated": &schema.Schema{
+				Description: "The last time this resource was updated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"object_type": &schema.Schema{
+				Description: "The `object_type` the custom field belongs to. (e.g., building, subnet, vlan, vrfgroup, ipaddress)",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"key": &schema.Schema{
+				Description: "The `key` of the custom field.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"type": &schema.Schema{
+				Description: "The `type` of the custom field. (text, number, date, boolean, url, related_field)",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "text",
+				ValidateFunc: validation.StringInSlice([]string{
+					"text", "number", "date", "boolean", "url", "related_field",
+				}, false),
+			},
+			"related_model": &schema.Schema{
+				Description: "The `related_model` of a `related_field` custom field. (e.g., building)",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"mandatory": &schema.Schema{
+				Description: "Is this custom field `mandatory`?",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"default_value": &schema.Schema{
+				Description: "The `default_value` of the custom field.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"filterable": &schema.Schema{
+				Description: "Can the custom field be used as a filter?",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"notes": &schema.Schema{
+				Description: "`notes` for the custom field.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// a related_field custom field needs a related_model
+func resourceCustomFieldDefinitionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Get("type").(string) == "related_field" && d.Get("related_model").(string) == "" {
+		return fmt.Errorf("a related_field custom field requires a related_model")
+	}
+
+	return nil
+}
+
+func resourceCustomFieldDefinitionSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	key := d.Get("key").(string)
+	fieldType := d.Get("type").(string)
+	relatedModel := d.Get("related_model").(string)
+
+	log.Println(fmt.Sprintf("[DEBUG] custom field : %s", key))
+
+	p := url.Values{}
+	p.Set("object_type", d.Get("object_type").(string))
+	p.Set("key", key)
+	p.Set("type", fieldType)
+	p.Set("mandatory", yesNo(d.Get("mandatory").(bool)))
+	p.Set("filterable", yesNo(d.Get("filterable").(bool)))
+	p.Set("default_value", d.Get("default_value").(string))
+	p.Set("notes", d.Get("notes").(string))
+	if relatedModel != "" {
+		p.Set("related_model", relatedModel)
+	}
+
+	id, err := apiPost(c, "/custom_field_definitions/", p)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create custom field with key " + key,
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(id))
+
+	return resourceCustomFieldDefinitionRead(ctx, d, m)
+}
+
+func resourceCustomFieldDefinitionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read id",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	definition, err := getCustomFieldDefinitionByID(c, id)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get custom field with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	log.Println(fmt.Sprintf("[DEBUG] custom field : %v", definition))
+
+	_ = d.Set("object_type", definition.ObjectType)
+	_ = d.Set("key", definition.Key)
+	_ = d.Set("type", definition.Type)
+	_ = d.Set("related_model", definition.RelatedModel)
+	_ = d.Set("mandatory", definition.Mandatory)
+	_ = d.Set("default_value", definition.DefaultValue)
+	_ = d.Set("filterable", definition.Filterable)
+	_ = d.Set("notes", definition.Notes)
+
+	return diags
+}
+
+// delete custom field definition
+func resourceCustomFieldDefinitionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+	var diags diag.Diagnostics
+
+	err := apiDelete(c, "/custom_field_definitions/"+d.Id()+"/")
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete custom field with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// getCustomFieldDefinitionByID will return a custom field definition by id
+func getCustomFieldDefinitionByID(c *device42.API, id int) (*customFieldDefinition, error) {
+	definitions := make([]customFieldDefinition, 0)
+	if err := apiGetList(c, "/custom_field_definitions/", url.Values{}, "custom_field_definitions", 0, &definitions); err != nil {
+		return nil, err
+	}
+
+	for _, i := range definitions {
+		if i.ID == id {
+			return &i, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find custom field with id %d", id)
+}
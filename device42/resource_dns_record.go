@@ -0,0 +1,260 @@
+package device42
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// errDNSRecordNotFound is returned by getDNSRecordByID when device42 has no
+// record with the id
+var errDNSRecordNotFound = errors.New("could not find dns record")
+
+// dnsRecord type
+type dnsRecord struct {
+	ID       int    `json:"id"`
+	Zone     string `json:"domain"`
+	Type     string `json:"type"`
+	Name     string `json:"name"`
+	Content  string `json:"content"`
+	TTL      int    `json:"ttl"`
+	Priority int    `json:"prio"`
+}
+
+func resourceDNSRecord() *schema.Resource {
+	return &schema.Resource{
+		Description:   "`device42_dns_record` resource can be used to create, update or delete a DNS record.",
+		CreateContext: resourceDNSRecordCreate,
+		ReadContext:   resourceDNSRecordRead,
+		UpdateContext: resourceDNSRecordUpdate,
+		DeleteContext: resourceDNSRecordDelete,
+		CustomizeDiff: resourceDNSRecordCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"last_updated": &schema.Schema{
+				Description: "The last time this resource was updated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"zone": &schema.Schema{
+				Description:  "The name of the DNS `zone` the record belongs to.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDNSName,
+			},
+			"type": &schema.Schema{
+				Description:  "The `type` of the DNS record. (A, AAAA, CNAME, PTR, TXT, SRV or MX)",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(dnsRecordTypes, false),
+			},
+			"name": &schema.Schema{
+				Description: "The fully qualified `name` of the DNS record.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"content": &schema.Schema{
+				Description: "The `content` of the DNS record. An SRV record's content is \"weight port target\".",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"ttl": &schema.Schema{
+				Description:  "The `ttl` of the DNS record. Defaults to the TTL of the zone.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"priority": &schema.Schema{
+				Description:  "The `priority` of an MX or SRV record.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 65535),
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// make sure the content of a record is valid for its type at plan time
+func resourceDNSRecordCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("type") || !d.NewValueKnown("content") {
+		return nil
+	}
+
+	return validateDNSRecordContent(d.Get("type").(string), d.Get("content").(string))
+}
+
+func resourceDNSRecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	record := expandDNSRecord(d)
+
+	log.Println(fmt.Sprintf("[DEBUG] dns record : %v", record))
+
+	id, err := createDNSRecord(c, record)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create dns record with name " + record.Name,
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(id))
+
+	return resourceDNSRecordRead(ctx, d, m)
+}
+
+func resourceDNSRecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	record := expandDNSRecord(d)
+
+	log.Println(fmt.Sprintf("[DEBUG] dns record : %v", record))
+
+	_, err := apiPut(c, "/dns/records/"+d.Id()+"/", dnsRecordParameters(record))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to update dns record with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return resourceDNSRecordRead(ctx, d, m)
+}
+
+func resourceDNSRecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read id",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	record, err := getDNSRecordByID(c, id)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get dns record with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	log.Println(fmt.Sprintf("[DEBUG] dns record : %v", record))
+
+	_ = d.Set("zone", record.Zone)
+	_ = d.Set("type", record.Type)
+	_ = d.Set("name", record.Name)
+	_ = d.Set("content", record.Content)
+	_ = d.Set("ttl", record.TTL)
+	_ = d.Set("priority", record.Priority)
+
+	return diags
+}
+
+// delete dns record
+func resourceDNSRecordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+	var diags diag.Diagnostics
+
+	err := apiDelete(c, "/dns/records/"+d.Id()+"/")
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete dns record with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func expandDNSRecord(d *schema.ResourceData) *dnsRecord {
+	return &dnsRecord{
+		Zone:     d.Get("zone").(string),
+		Type:     d.Get("type").(string),
+		Name:     d.Get("name").(string),
+		Content:  d.Get("content").(string),
+		TTL:      d.Get("ttl").(int),
+		Priority: d.Get("priority").(int),
+	}
+}
+
+func dnsRecordParameters(record *dnsRecord) url.Values {
+	p := url.Values{}
+	p.Set("domain", record.Zone)
+	p.Set("type", record.Type)
+	p.Set("name", record.Name)
+	p.Set("content", record.Content)
+	if record.TTL != 0 {
+		p.Set("ttl", strconv.Itoa(record.TTL))
+	}
+	if record.Type == "MX" || record.Type == "SRV" {
+		p.Set("prio", strconv.Itoa(record.Priority))
+	}
+	return p
+}
+
+// createDNSRecord will add a dns record and return its id
+func createDNSRecord(c *device42.API, record *dnsRecord) (int, error) {
+	return apiPost(c, "/dns/records/", dnsRecordParameters(record))
+}
+
+// getDNSRecords will return a list of dns records. q can filter on domain,
+// type and name.
+func getDNSRecords(c *device42.API, q url.Values) ([]dnsRecord, error) {
+	records := make([]dnsRecord, 0)
+	if err := apiGetList(c, "/dns/records/", q, "dns_records", 0, &records); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// getDNSRecordByID will return a dns record by id
+func getDNSRecordByID(c *device42.API, id int) (*dnsRecord, error) {
+	records, err := getDNSRecords(c, url.Values{"id": {strconv.Itoa(id)}})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range records {
+		if i.ID == id {
+			return &i, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w with id %d", errDNSRecordNotFound, id)
+}
@@ -0,0 +1,194 @@
+package device42
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// dnsZone type
+type dnsZone struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	Nameserver string `json:"nameserver"`
+	TTL        int    `json:"ttl"`
+	Notes      string `json:"notes"`
+}
+
+func resourceDNSZone() *schema.Resource {
+	return &schema.Resource{
+		Description:   "`device42_dns_zone` resource can be used to create, update or delete a DNS zone.",
+		CreateContext: resourceDNSZoneSet,
+		ReadContext:   resourceDNSZoneRead,
+		UpdateContext: resourceDNSZoneSet,
+		DeleteContext: resourceDNSZoneDelete,
+		Schema: map[string]*schema.Schema{
+			"last_updated": &schema.Schema{
+				Description: "The last time this resource was updated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": &schema.Schema{
+				Description:  "The `name` of the DNS zone. (e.g., example.com or 10.in-addr.arpa)",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDNSName,
+			},
+			"nameserver": &schema.Schema{
+				Description: "The `nameserver` that is authoritative for the DNS zone.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"ttl": &schema.Schema{
+				Description:  "The default `ttl` of records in the DNS zone.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3600,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"notes": &schema.Schema{
+				Description: "`notes` for the DNS zone.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceDNSZoneSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+
+	log.Println(fmt.Sprintf("[DEBUG] dns zone : %s", name))
+
+	id, err := apiPost(c, "/dns/zones/", url.Values{
+		"name":       {name},
+		"nameserver": {d.Get("nameserver").(string)},
+		"ttl":        {strconv.Itoa(d.Get("ttl").(int))},
+		"notes":      {d.Get("notes").(string)},
+	})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create dns zone with name " + name,
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(id))
+
+	return resourceDNSZoneRead(ctx, d, m)
+}
+
+func resourceDNSZoneRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read id",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	zone, err := getDNSZoneByID(c, id)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get dns zone with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	log.Println(fmt.Sprintf("[DEBUG] dns zone : %v", zone))
+
+	_ = d.Set("name", zone.Name)
+	_ = d.Set("nameserver", zone.Nameserver)
+	_ = d.Set("ttl", zone.TTL)
+	_ = d.Set("notes", zone.Notes)
+
+	return diags
+}
+
+// delete dns zone
+func resourceDNSZoneDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+	var diags diag.Diagnostics
+
+	err := apiDelete(c, "/dns/zones/"+d.Id()+"/")
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete dns zone with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// getDNSZones will return a list of dns zones
+func getDNSZones(c *device42.API, q url.Values) ([]dnsZone, error) {
+	zones := make([]dnsZone, 0)
+	if err := apiGetList(c, "/dns/zones/", q, "dns_zones", 0, &zones); err != nil {
+		return nil, err
+	}
+
+	return zones, nil
+}
+
+// getDNSZoneByID will return a dns zone by id
+func getDNSZoneByID(c *device42.API, id int) (*dnsZone, error) {
+	zones, err := getDNSZones(c, url.Values{"id": {strconv.Itoa(id)}})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range zones {
+		if i.ID == id {
+			return &i, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find dns zone with id %d", id)
+}
+
+// getDNSZoneByName will return a dns zone by name
+func getDNSZoneByName(c *device42.API, name string) (*dnsZone, error) {
+	zones, err := getDNSZones(c, url.Values{"name": {name}})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range zones {
+		if i.Name == name {
+			return &i, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find dns zone with name %s", name)
+}
@@ -17,7 +17,7 @@ func resourceDynamicIP() *schema.Resource {
 		Description:   "`device42_dynamic_ip` data resource can be used to generate a new IP",
 		CreateContext: resourceDynamicIPSet,
 		ReadContext:   resourceDynamicIPRead,
-		UpdateContext: resourceDynamicIPSet,
+		UpdateContext: resourceDynamicIPUpdate,
 		DeleteContext: resourceDynamicIPDelete,
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -39,6 +39,7 @@ func resourceDynamicIP() *schema.Resource {
 				Description: "The `mask_bits` for the IP.",
 				Type:        schema.TypeInt,
 				Required:    true,
+				ForceNew:    true,
 			},
 			"subnet": &schema.Schema{
 				Description: "The `subnet` for the IP.",
@@ -51,6 +52,7 @@ func resourceDynamicIP() *schema.Resource {
 				Type:        schema.TypeInt,
 				Computed:    true,
 				Optional:    true,
+				ForceNew:    true,
 			},
 			"vrf_group": &schema.Schema{
 				Description: "The `vrf_group` for the IP.",
@@ -62,38 +64,35 @@ func resourceDynamicIP() *schema.Resource {
 				Description: "The `vrf_group_id` for the IP.",
 				Type:        schema.TypeInt,
 				Optional:    true,
+				ForceNew:    true,
 			},
+			"custom_fields": customFieldsResourceSchema(),
+			"dns":           ipDNSSchema(),
 		},
 	}
 }
+
+// update the ip in place. changes to how the ip is allocated replace it.
 func resourceDynamicIPUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*device42.API)
 
 	var diags diag.Diagnostics
-	var err error
-
-	ipID := d.Get("id").(string)
-	ipSubnetID := d.Get("subnet_id").(int)
-	ipVRFGroup := d.Get("vrf_group").(string)
-	ipVRFGroupID := d.Get("vrf_group_id").(int)
 
-	var id int
-	_, err = fmt.Sscan(ipID, &id)
+	id, err := strconv.Atoi(d.Id())
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
-			Summary:  "unable to suggest ip",
+			Summary:  "unable to read id",
 			Detail:   err.Error(),
 		})
 		return diags
 	}
 
 	ip, err := c.GetIPByID(id)
-
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
-			Summary:  "unable to suggest ip",
+			Summary:  "unable to get ip with id " + d.Id(),
 			Detail:   err.Error(),
 		})
 		return diags
@@ -101,11 +100,27 @@ func resourceDynamicIPUpdate(ctx context.Context, d *schema.ResourceData, m inte
 
 	log.Println(fmt.Sprintf("[DEBUG] ip : %v", ip))
 
-	_ = d.Set("subnet_id", ipSubnetID)
-	_ = d.Set("vrf_group_id", ipVRFGroupID)
-	_ = d.Set("vrf_group", ipVRFGroup)
+	err = setCustomFields(c, d, "ipaddress", ipCustomFieldParameters(ip))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to set custom fields for ip with address " + ip.Address,
+			Detail:   err.Error(),
+		})
+		return diags
+	}
 
-	return diags
+	err = setIPDNSRecords(c, d, ip.Address)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to set dns records for ip with address " + ip.Address,
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return resourceDynamicIPRead(ctx, d, m)
 }
 
 func resourceDynamicIPSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -156,6 +171,26 @@ func resourceDynamicIPSet(ctx context.Context, d *schema.ResourceData, m interfa
 
 	d.SetId(strconv.Itoa(ip.ID))
 
+	err = setCustomFields(c, d, "ipaddress", ipCustomFieldParameters(ip))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to set custom fields for ip with address " + ip.Address,
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	err = setIPDNSRecords(c, d, ip.Address)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to set dns records for ip with address " + ip.Address,
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
 	resourceDynamicIPRead(ctx, d, m)
 
 	return diags
@@ -201,6 +236,7 @@ func resourceDynamicIPRead(ctx context.Context, d *schema.ResourceData, m interf
 	_ = d.Set("subnet", ip.Subnet)
 	_ = d.Set("subnet_id", ip.SubnetID)
 	_ = d.Set("vrf_group", ip.VRFGroup)
+	_ = d.Set("custom_fields", flattenManagedCustomFields(customFieldsFromIP(ip), d))
 
 	return diags
 }
@@ -222,6 +258,16 @@ func resourceDynamicIPDelete(ctx context.Context, d *schema.ResourceData, m inte
 		return diags
 	}
 
+	err = deleteIPDNSRecords(c, d)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete dns records for ip with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
 	err = c.DeleteIP(id)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"net/url"
 	"strconv"
 
 	"github.com/chopnico/device42-go"
@@ -18,7 +19,7 @@ func resourceDynamicSubnet() *schema.Resource {
 		Description:   "`device42_dynamic_subnet` resource can be used to generate a new subnet.",
 		CreateContext: resourceDynamicSubnetSet,
 		ReadContext:   resourceDynamicSubnetRead,
-		UpdateContext: resourceDynamicSubnetSet,
+		UpdateContext: resourceDynamicSubnetUpdate,
 		DeleteContext: resourceDynamicSubnetDelete,
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -35,11 +36,13 @@ func resourceDynamicSubnet() *schema.Resource {
 				Description: "The `parent_subnet_id` of the dynamic subnet.",
 				Type:        schema.TypeInt,
 				Required:    true,
+				ForceNew:    true,
 			},
 			"mask_bits": &schema.Schema{
 				Description: "The `mask_bits` of the dynamic subnet.",
 				Type:        schema.TypeInt,
 				Required:    true,
+				ForceNew:    true,
 			},
 			"mask": &schema.Schema{
 				Description: "The `mask` of the dynamic subnet.",
@@ -76,6 +79,7 @@ func resourceDynamicSubnet() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"custom_fields": customFieldsResourceSchema(),
 		},
 	}
 }
@@ -122,9 +126,76 @@ func resourceDynamicSubnetSet(ctx context.Context, d *schema.ResourceData, m int
 
 	d.SetId(strconv.Itoa(subnet.SubnetID))
 
-	resourceSubnetRead(ctx, d, m)
+	err = setCustomFields(c, d, "subnet", url.Values{"subnet_id": {d.Id()}})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to set custom fields for subnet with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
 
-	return diags
+	return resourceDynamicSubnetRead(ctx, d, m)
+}
+
+// update the subnet in place. changes to how the subnet is allocated
+// replace it.
+func resourceDynamicSubnetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	subnetID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read id",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	subnet, err := c.GetSubnetByID(subnetID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get subnet with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	subnet.Name = d.Get("name").(string)
+	subnet.Tags = interfaceSliceToStringSlice(d.Get("tags").([]interface{}))
+	subnet.Gateway = ""
+	if !d.Get("is_supernet").(bool) {
+		subnet.Gateway = ipv4GatewayFromNetwork(subnet.Network)
+	}
+
+	log.Println(fmt.Sprintf("[DEBUG] subnet : %v", subnet))
+
+	_, err = c.SetSubnet(subnet)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to update subnet with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	err = setCustomFields(c, d, "subnet", url.Values{"subnet_id": {d.Id()}})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to set custom fields for subnet with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return resourceDynamicSubnetRead(ctx, d, m)
 }
 
 func resourceDynamicSubnetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -164,6 +235,7 @@ func resourceDynamicSubnetRead(ctx context.Context, d *schema.ResourceData, m in
 	_ = d.Set("parenet_subnet_id", subnet.ParentSubnetID)
 	_ = d.Set("vrf_group_id", subnet.VrfGroupID)
 	_ = d.Set("tags", subnet.Tags)
+	_ = d.Set("custom_fields", flattenManagedCustomFields(customFieldsFromInterface(subnet.CustomFields), d))
 
 	return diags
 }
@@ -0,0 +1,325 @@
+package device42
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// dynamicVLANMutex serializes allocation so parallel creates in the same
+// run can't pick the same number
+var dynamicVLANMutex sync.Mutex
+
+func resourceDynamicVLAN() *schema.Resource {
+	return &schema.Resource{
+		Description:   "`device42_dynamic_vlan` resource can be used to create a VLAN using the first free number within a range.",
+		CreateContext: resourceDynamicVLANSet,
+		ReadContext:   resourceDynamicVLANRead,
+		UpdateContext: resourceDynamicVLANUpdate,
+		DeleteContext: resourceDynamicVLANDelete,
+		CustomizeDiff: resourceDynamicVLANCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"last_updated": &schema.Schema{
+				Description: "The last time this resource was updated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": &schema.Schema{
+				Description: "The `name` of the dynamic VLAN.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"number_min": &schema.Schema{
+				Description:  "The lowest VLAN number that can be allocated. Changing the range only replaces the VLAN when its number is outside of the new range.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 4094),
+			},
+			"number_max": &schema.Schema{
+				Description:  "The highest VLAN number that can be allocated.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 4094),
+			},
+			"scope_switch_id": &schema.Schema{
+				Description: "Only VLANs bound to this switch are considered in use. The new VLAN is bound to it.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"scope_tag": &schema.Schema{
+				Description: "Only VLANs with this tag are considered in use. The new VLAN is tagged with it.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"number": &schema.Schema{
+				Description: "The allocated VLAN `number`.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"description": &schema.Schema{
+				Description: "The `description` of the dynamic VLAN.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"notes": &schema.Schema{
+				Description: "`notes` for the dynamic VLAN.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"tags": &schema.Schema{
+				Description: "The `tags` of the dynamic VLAN.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// the number range can't be empty, and a VLAN is only replaced when its
+// number is outside of a changed range
+func resourceDynamicVLANCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	numberMin := d.Get("number_min").(int)
+	numberMax := d.Get("number_max").(int)
+	if numberMin > numberMax {
+		return fmt.Errorf("number_min %d is greater than number_max %d", numberMin, numberMax)
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+	if number := d.Get("number").(int); number >= numberMin && number <= numberMax {
+		return nil
+	}
+	for _, k := range []string{"number_min", "number_max"} {
+		if d.HasChange(k) {
+			return d.ForceNew(k)
+		}
+	}
+
+	return nil
+}
+
+func resourceDynamicVLANSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	numberMin := d.Get("number_min").(int)
+	numberMax := d.Get("number_max").(int)
+	switchID := d.Get("scope_switch_id").(int)
+	scopeTag := d.Get("scope_tag").(string)
+
+	tags := interfaceSliceToStringSlice(d.Get("tags").([]interface{}))
+	if scopeTag != "" && !stringInSlice(scopeTag, tags) {
+		tags = append(tags, scopeTag)
+	}
+
+	dynamicVLANMutex.Lock()
+	defer dynamicVLANMutex.Unlock()
+
+	q := url.Values{}
+	if scopeTag != "" {
+		q.Set("tags", scopeTag)
+	}
+
+	vlans, err := getVLANs(c, q, 0)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get a list of VLANs",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	number := freeVLANNumber(filterVLANs(vlans, 0, "", switchID, scopeTag), numberMin, numberMax)
+	if number == 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to allocate VLAN",
+			Detail:   fmt.Sprintf("no free VLAN number between %d and %d", numberMin, numberMax),
+		})
+		return diags
+	}
+
+	log.Println(fmt.Sprintf("[DEBUG] VLAN number : %d", number))
+
+	vlan, err := c.SetVLAN(&device42.VLAN{
+		Name:        d.Get("name").(string),
+		Number:      number,
+		Description: d.Get("description").(string),
+		Notes:       d.Get("notes").(string),
+		Tags:        tags,
+	})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create VLAN with number " + strconv.Itoa(number),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(vlan.VlanID))
+
+	if switchID != 0 {
+		_, err = apiPut(c, "/vlans/"+d.Id()+"/", url.Values{"switch_ids": {strconv.Itoa(switchID)}})
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to set switches for VLAN with id " + d.Id(),
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return resourceDynamicVLANRead(ctx, d, m)
+}
+
+// update the vlan in place so its number never changes
+func resourceDynamicVLANUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	tags := interfaceSliceToStringSlice(d.Get("tags").([]interface{}))
+	if scopeTag := d.Get("scope_tag").(string); scopeTag != "" && !stringInSlice(scopeTag, tags) {
+		tags = append(tags, scopeTag)
+	}
+
+	_, err := apiPut(c, "/vlans/"+d.Id()+"/", url.Values{
+		"name":        {d.Get("name").(string)},
+		"description": {d.Get("description").(string)},
+		"notes":       {d.Get("notes").(string)},
+		"tags":        {strings.Join(tags, ",")},
+	})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to update VLAN with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return resourceDynamicVLANRead(ctx, d, m)
+}
+
+func resourceDynamicVLANRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	vlanID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read id",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	vlan, err := c.GetVLANByID(vlanID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get VLAN with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	log.Println(fmt.Sprintf("[DEBUG] VLAN : %v", vlan))
+
+	_ = d.Set("name", vlan.Name)
+	_ = d.Set("number", vlan.Number)
+	_ = d.Set("description", vlan.Description)
+	_ = d.Set("notes", vlan.Notes)
+	_ = d.Set("tags", dynamicVLANTags(vlan.Tags, d))
+
+	return diags
+}
+
+// dynamicVLANTags will leave the scope tag, which is added to the VLAN on
+// create, out of its tags unless the configuration lists it too
+func dynamicVLANTags(tags []string, d *schema.ResourceData) []string {
+	scopeTag := d.Get("scope_tag").(string)
+	if scopeTag == "" || stringInSlice(scopeTag, interfaceSliceToStringSlice(d.Get("tags").([]interface{}))) {
+		return tags
+	}
+
+	t := make([]string, 0, len(tags))
+	for _, i := range tags {
+		if i != scopeTag {
+			t = append(t, i)
+		}
+	}
+	return t
+}
+
+// delete dynamic vlan
+func resourceDynamicVLANDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+	var diags diag.Diagnostics
+
+	var id int
+	_, err := fmt.Sscan(d.Id(), &id)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get VLAN id",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	err = c.DeleteVLAN(id)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete VLAN with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// freeVLANNumber will return the first number between min and max that isn't
+// used by any of the vlans, or 0 when the range is full
+func freeVLANNumber(vlans []device42.VLAN, min, max int) int {
+	used := make(map[int]bool, len(vlans))
+	for _, v := range vlans {
+		used[v.Number] = true
+	}
+
+	for n := min; n <= max; n++ {
+		if !used[n] {
+			return n
+		}
+	}
+
+	return 0
+}
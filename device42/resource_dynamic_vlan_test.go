@@ -0,0 +1,111 @@
+package device42
+
+import (
+	"testing"
+
+	device42 "github.com/chopnico/device42-go"
+)
+
+func testVLANs(numbers ...int) []device42.VLAN {
+	vlans := make([]device42.VLAN, len(numbers))
+	for n, i := range numbers {
+		vlans[n] = device42.VLAN{Number: i}
+	}
+	return vlans
+}
+
+func TestFreeVLANNumber(t *testing.T) {
+	tests := []struct {
+		name     string
+		vlans    []device42.VLAN
+		min, max int
+		want     int
+	}{
+		{
+			name: "no vlans",
+			min:  100,
+			max:  200,
+			want: 100,
+		},
+		{
+			name:  "first gap",
+			vlans: testVLANs(100, 101, 103, 105),
+			min:   100,
+			max:   200,
+			want:  102,
+		},
+		{
+			name:  "unsorted",
+			vlans: testVLANs(102, 100, 101),
+			min:   100,
+			max:   200,
+			want:  103,
+		},
+		{
+			name:  "vlans outside of the range",
+			vlans: testVLANs(1, 99, 201),
+			min:   100,
+			max:   200,
+			want:  100,
+		},
+		{
+			name:  "last number",
+			vlans: testVLANs(100, 101, 102),
+			min:   100,
+			max:   103,
+			want:  103,
+		},
+		{
+			name:  "duplicate numbers",
+			vlans: testVLANs(100, 100, 101),
+			min:   100,
+			max:   102,
+			want:  102,
+		},
+		{
+			name:  "range of one",
+			vlans: testVLANs(99, 101),
+			min:   100,
+			max:   100,
+			want:  100,
+		},
+		{
+			name:  "exhausted",
+			vlans: testVLANs(100, 101, 102),
+			min:   100,
+			max:   102,
+			want:  0,
+		},
+		{
+			name:  "exhausted range of one",
+			vlans: testVLANs(4094),
+			min:   4094,
+			max:   4094,
+			want:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := freeVLANNumber(tt.vlans, tt.min, tt.max); got != tt.want {
+				t.Errorf("freeVLANNumber() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterVLANsByTag(t *testing.T) {
+	vlans := []device42.VLAN{
+		{Number: 100, Tags: []string{"a"}},
+		{Number: 101, Tags: []string{"b"}},
+		{Number: 102},
+	}
+
+	// only vlans with the scope tag are in use
+	if got := freeVLANNumber(filterVLANs(vlans, 0, "", 0, "b"), 100, 102); got != 100 {
+		t.Errorf("freeVLANNumber() = %d, want 100", got)
+	}
+	if got := freeVLANNumber(filterVLANs(vlans, 0, "", 0, ""), 100, 103); got != 103 {
+		t.Errorf("freeVLANNumber() = %d, want 103", got)
+	}
+}
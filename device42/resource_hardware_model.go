@@ -0,0 +1,266 @@
+package device42
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// hardwareModel type
+type hardwareModel struct {
+	ID           int     `json:"hardware_id"`
+	Name         string  `json:"name"`
+	Manufacturer string  `json:"manufacturer"`
+	Type         string  `json:"type"`
+	Size         float64 `json:"size"`
+	Depth        string  `json:"depth"`
+	Watts        int     `json:"watts"`
+	PartNumber   string  `json:"part_no"`
+	Notes        string  `json:"notes"`
+}
+
+// the appliance takes hardware types and depths as numbers
+var hardwareModelTypes = map[string]string{"regular": "1", "blade": "2", "other": "3"}
+var hardwareModelDepths = map[string]string{"full": "1", "half": "2"}
+
+func resourceHardwareModel() *schema.Resource {
+	return &schema.Resource{
+		Description:   "`device42_hardware_model` resource can be used to create, update or delete a hardware model.",
+		CreateContext: resourceHardwareModelSet,
+		ReadContext:   resourceHardwareModelRead,
+		UpdateContext: resourceHardwareModelSet,
+		DeleteContext: resourceHardwareModelDelete,
+		Schema: map[string]*schema.Schema{
+			"last_updated": &schema.Schema{
+				Description: "The last time this resource was updated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": &schema.Schema{
+				Description: "The `name` of the hardware model.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"vendor_id": &schema.Schema{
+				Description: "The `vendor_id` of the vendor that makes the hardware model.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"type": &schema.Schema{
+				Description:  "The `type` of the hardware model. (regular, blade or other)",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "regular",
+				ValidateFunc: validation.StringInSlice([]string{"regular", "blade", "other"}, false),
+			},
+			"size": &schema.Schema{
+				Description:  "The `size` of the hardware model in rack units.",
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			"depth": &schema.Schema{
+				Description:  "The `depth` of the hardware model. (full or half)",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "full",
+				ValidateFunc: validation.StringInSlice([]string{"full", "half"}, false),
+			},
+			"watts": &schema.Schema{
+				Description:  "The power the hardware model draws in `watts`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"part_number": &schema.Schema{
+				Description: "The `part_number` of the hardware model.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"notes": &schema.Schema{
+				Description: "`notes` for the hardware model.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceHardwareModelSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+
+	log.Println(fmt.Sprintf("[DEBUG] hardware model : %s", name))
+
+	p := url.Values{}
+	p.Set("name", name)
+	p.Set("type", hardwareModelTypes[d.Get("type").(string)])
+	p.Set("depth", hardwareModelDepths[d.Get("depth").(string)])
+	p.Set("size", strconv.FormatFloat(d.Get("size").(float64), 'f', -1, 64))
+	p.Set("watts", strconv.Itoa(d.Get("watts").(int)))
+	p.Set("part_no", d.Get("part_number").(string))
+	p.Set("notes", d.Get("notes").(string))
+
+	vendorID := d.Get("vendor_id").(int)
+	manufacturer, err := vendorNameByID(c, vendorID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get vendor with id " + strconv.Itoa(vendorID),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	p.Set("manufacturer", manufacturer)
+
+	id, err := apiPost(c, "/hardwares/", p)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create hardware model with name " + name,
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(id))
+
+	return resourceHardwareModelRead(ctx, d, m)
+}
+
+func resourceHardwareModelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read id",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	model, err := getHardwareModelByID(c, id)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get hardware model with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	log.Println(fmt.Sprintf("[DEBUG] hardware model : %v", model))
+
+	vendorID, err := vendorIDByName(c, model.Manufacturer)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get vendor with name " + model.Manufacturer,
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	_ = d.Set("name", model.Name)
+	_ = d.Set("vendor_id", vendorID)
+	_ = d.Set("type", hardwareModelAttribute(model.Type))
+	_ = d.Set("size", model.Size)
+	_ = d.Set("depth", hardwareModelAttribute(model.Depth))
+	_ = d.Set("watts", model.Watts)
+	_ = d.Set("part_number", model.PartNumber)
+	_ = d.Set("notes", model.Notes)
+
+	return diags
+}
+
+// delete hardware model
+func resourceHardwareModelDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+	var diags diag.Diagnostics
+
+	err := apiDelete(c, "/hardwares/"+d.Id()+"/")
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete hardware model with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// hardwareModelAttribute converts the type or depth the appliance returns
+// (e.g., Regular or Half Depth) to its attribute value
+func hardwareModelAttribute(v string) string {
+	f := strings.Fields(strings.ToLower(v))
+	if len(f) == 0 {
+		return ""
+	}
+	return f[0]
+}
+
+// getHardwareModels will return a list of hardware models matching q
+func getHardwareModels(c *device42.API, q url.Values) ([]hardwareModel, error) {
+	models := make([]hardwareModel, 0)
+	if err := apiGetList(c, "/hardwares/", q, "models", 0, &models); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+// getHardwareModelByID will return a hardware model by id
+func getHardwareModelByID(c *device42.API, id int) (*hardwareModel, error) {
+	models, err := getHardwareModels(c, url.Values{"hardware_id": {strconv.Itoa(id)}})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range models {
+		if i.ID == id {
+			return &i, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find hardware model with id %d", id)
+}
+
+// getHardwareModelByName will return a hardware model by name
+func getHardwareModelByName(c *device42.API, name string) (*hardwareModel, error) {
+	models, err := getHardwareModels(c, url.Values{"name": {name}})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range models {
+		if i.Name == name {
+			return &i, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find hardware model with name %s", name)
+}
@@ -57,6 +57,8 @@ func resourceIP() *schema.Resource {
 				Required:    false,
 				Optional:    true,
 			},
+			"custom_fields": customFieldsResourceSchema(),
+			"dns":           ipDNSSchema(),
 		},
 	}
 }
@@ -90,6 +92,26 @@ func resourceIPSet(ctx context.Context, d *schema.ResourceData, m interface{}) d
 
 	d.SetId(strconv.Itoa(ip.ID))
 
+	err = setCustomFields(c, d, "ipaddress", ipCustomFieldParameters(ip))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to set custom fields for IP with address " + ip.Address,
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	err = setIPDNSRecords(c, d, ip.Address)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to set dns records for IP with address " + ip.Address,
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
 	resourceIPRead(ctx, d, m)
 
 	return diags
@@ -128,6 +150,7 @@ func resourceIPRead(ctx context.Context, d *schema.ResourceData, m interface{})
 	_ = d.Set("subnet", ip.Subnet)
 	_ = d.Set("subnet_id", ip.SubnetID)
 	_ = d.Set("vrf_group", ip.VRFGroup)
+	_ = d.Set("custom_fields", flattenManagedCustomFields(customFieldsFromIP(ip), d))
 
 	return diags
 }
@@ -149,6 +172,16 @@ func resourceIPDelete(ctx context.Context, d *schema.ResourceData, m interface{}
 		return diags
 	}
 
+	err = deleteIPDNSRecords(c, d)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete dns records for IP with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
 	err = c.DeleteIP(id)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
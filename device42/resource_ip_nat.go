@@ -0,0 +1,340 @@
+package device42
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ipNAT type
+type ipNAT struct {
+	ID              int    `json:"id"`
+	InsideIPID      int    `json:"ip_address_from_id"`
+	InsideAddress   string `json:"ip_address_from"`
+	InsidePort      int    `json:"port_from"`
+	OutsideIPID     int    `json:"ip_address_to_id"`
+	OutsideAddress  string `json:"ip_address_to"`
+	OutsidePort     int    `json:"port_to"`
+	Type            string `json:"type"`
+	Protocol        string `json:"protocol"`
+	NetworkDeviceID int    `json:"network_device_id"`
+}
+
+func resourceIPNAT() *schema.Resource {
+	return &schema.Resource{
+		Description:   "`device42_ip_nat` resource can be used to create, update or delete a NAT between an inside and an outside IP.",
+		CreateContext: resourceIPNATCreate,
+		ReadContext:   resourceIPNATRead,
+		UpdateContext: resourceIPNATUpdate,
+		DeleteContext: resourceIPNATDelete,
+		Schema: map[string]*schema.Schema{
+			"last_updated": &schema.Schema{
+				Description: "The last time this resource was updated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"inside_ip_id": &schema.Schema{
+				Description:  "The id of the inside IP.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"inside_ip_id", "inside_address"},
+			},
+			"inside_address": &schema.Schema{
+				Description:  "The address of the inside IP. Use `inside_subnet_id` when the address exists in more than one subnet.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"inside_ip_id", "inside_address"},
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"inside_subnet_id": &schema.Schema{
+				Description:   "The subnet id of the inside IP.",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"inside_ip_id"},
+			},
+			"inside_port": &schema.Schema{
+				Description:  "The inside port of a port NAT.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"protocol"},
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"outside_ip_id": &schema.Schema{
+				Description:  "The id of the outside IP.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"outside_ip_id", "outside_address"},
+			},
+			"outside_address": &schema.Schema{
+				Description:  "The address of the outside IP. Use `outside_subnet_id` when the address exists in more than one subnet.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"outside_ip_id", "outside_address"},
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"outside_subnet_id": &schema.Schema{
+				Description:   "The subnet id of the outside IP.",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"outside_ip_id"},
+			},
+			"outside_port": &schema.Schema{
+				Description:  "The outside port of a port NAT.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"protocol"},
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"type": &schema.Schema{
+				Description:  "The `type` of NAT. (static, dynamic or pat)",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "static",
+				ValidateFunc: validation.StringInSlice([]string{"static", "dynamic", "pat"}, false),
+			},
+			"protocol": &schema.Schema{
+				Description:  "The `protocol` of a port NAT. (tcp or udp)",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"tcp", "udp"}, false),
+			},
+			"network_device_id": &schema.Schema{
+				Description: "The device id of the `network_device` doing the NAT.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceIPNATCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	insideIPID, err := natIPID(c, d.Get("inside_ip_id").(int), d.Get("inside_address").(string), d.Get("inside_subnet_id").(int))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get inside ip",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	outsideIPID, err := natIPID(c, d.Get("outside_ip_id").(int), d.Get("outside_address").(string), d.Get("outside_subnet_id").(int))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get outside ip",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	p := ipNATParameters(d)
+	p.Set("ip_address_from_id", strconv.Itoa(insideIPID))
+	p.Set("ip_address_to_id", strconv.Itoa(outsideIPID))
+
+	log.Println(fmt.Sprintf("[DEBUG] ip nat : %s", p.Encode()))
+
+	id, err := apiPost(c, "/ip_nat/", p)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create ip nat from ip " + strconv.Itoa(insideIPID) + " to ip " + strconv.Itoa(outsideIPID),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(id))
+
+	return resourceIPNATRead(ctx, d, m)
+}
+
+func resourceIPNATUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	p := ipNATParameters(d)
+
+	log.Println(fmt.Sprintf("[DEBUG] ip nat : %s", p.Encode()))
+
+	_, err := apiPut(c, "/ip_nat/"+d.Id()+"/", p)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to update ip nat with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return resourceIPNATRead(ctx, d, m)
+}
+
+func resourceIPNATRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read id",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	nat, err := getIPNATByID(c, id)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get ip nat with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	log.Println(fmt.Sprintf("[DEBUG] ip nat : %v", nat))
+
+	_ = d.Set("inside_ip_id", nat.InsideIPID)
+	_ = d.Set("inside_address", nat.InsideAddress)
+	_ = d.Set("inside_port", nat.InsidePort)
+	_ = d.Set("outside_ip_id", nat.OutsideIPID)
+	_ = d.Set("outside_address", nat.OutsideAddress)
+	_ = d.Set("outside_port", nat.OutsidePort)
+	_ = d.Set("type", nat.Type)
+	_ = d.Set("protocol", nat.Protocol)
+	_ = d.Set("network_device_id", nat.NetworkDeviceID)
+
+	return diags
+}
+
+// delete ip nat
+func resourceIPNATDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+	var diags diag.Diagnostics
+
+	err := apiDelete(c, "/ip_nat/"+d.Id()+"/")
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete ip nat with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func ipNATParameters(d *schema.ResourceData) url.Values {
+	p := url.Values{}
+	p.Set("type", d.Get("type").(string))
+	p.Set("protocol", d.Get("protocol").(string))
+	if v := d.Get("inside_port").(int); v != 0 {
+		p.Set("port_from", strconv.Itoa(v))
+	}
+	if v := d.Get("outside_port").(int); v != 0 {
+		p.Set("port_to", strconv.Itoa(v))
+	}
+	if v := d.Get("network_device_id").(int); v != 0 {
+		p.Set("network_device_id", strconv.Itoa(v))
+	}
+	return p
+}
+
+// natIPID will return the id of an ip given either its id or its address
+func natIPID(c *device42.API, id int, address string, subnetID int) (int, error) {
+	if id != 0 {
+		return id, nil
+	}
+
+	ip, err := getIPByAddress(c, address, subnetID)
+	if err != nil {
+		return 0, err
+	}
+
+	return ip.ID, nil
+}
+
+// getIPByAddress will return an ip by address, optionally in a subnet. more
+// than one match is an error.
+func getIPByAddress(c *device42.API, address string, subnetID int) (*device42.IP, error) {
+	q := url.Values{"address": {address}}
+	if subnetID != 0 {
+		q.Set("subnet_id", strconv.Itoa(subnetID))
+	}
+
+	ips, err := getIPs(c, q, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(ips) {
+	case 0:
+		return nil, fmt.Errorf("no ip matched %s", q.Encode())
+	case 1:
+		return &ips[0], nil
+	default:
+		ids := make([]int, len(ips))
+		for n, i := range ips {
+			ids[n] = i.ID
+		}
+		return nil, fmt.Errorf("ips with ids %s matched %s. use a subnet id to narrow the search", intsToCommaString(ids), q.Encode())
+	}
+}
+
+// getIPNATs will return a list of ip nats matching q
+func getIPNATs(c *device42.API, q url.Values) ([]ipNAT, error) {
+	nats := make([]ipNAT, 0)
+	if err := apiGetList(c, "/ip_nat/", q, "ip_nat", 0, &nats); err != nil {
+		return nil, err
+	}
+
+	return nats, nil
+}
+
+// getIPNATByID will return an ip nat by id
+func getIPNATByID(c *device42.API, id int) (*ipNAT, error) {
+	nats, err := getIPNATs(c, url.Values{"id": {strconv.Itoa(id)}})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range nats {
+		if i.ID == id {
+			return &i, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find ip nat with id %d", id)
+}
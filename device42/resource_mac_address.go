@@ -0,0 +1,193 @@
+package device42
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// macAddress type
+type macAddress struct {
+	ID       int    `json:"macaddress_id"`
+	MAC      string `json:"macaddress"`
+	Vendor   string `json:"vendor"`
+	PortName string `json:"port_name"`
+	DeviceID int    `json:"device_id"`
+	VLANID   int    `json:"vlan_id"`
+}
+
+func resourceMACAddress() *schema.Resource {
+	return &schema.Resource{
+		Description:   "`device42_mac_address` resource can be used to create, update or delete a MAC address.",
+		CreateContext: resourceMACAddressSet,
+		ReadContext:   resourceMACAddressRead,
+		UpdateContext: resourceMACAddressSet,
+		DeleteContext: resourceMACAddressDelete,
+		Schema: map[string]*schema.Schema{
+			"last_updated": &schema.Schema{
+				Description: "The last time this resource was updated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"mac": &schema.Schema{
+				Description:  "The `mac` address. Colon, hyphen, dot and bare hex notations are accepted and stored as lower case colon separated hex.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateMACAddress,
+				StateFunc:    macAddressStateFunc,
+			},
+			"vendor": &schema.Schema{
+				Description: "The `vendor` of the MAC address, looked up by Device42 from its OUI.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"port_name": &schema.Schema{
+				Description: "The name of the port the MAC address belongs to.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"device_id": &schema.Schema{
+				Description: "The id of the device the MAC address belongs to.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"vlan_id": &schema.Schema{
+				Description: "The id of the VLAN the MAC address belongs to.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceMACAddressSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	mac, err := normalizeMACAddress(d.Get("mac").(string))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "invalid mac address",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	log.Println(fmt.Sprintf("[DEBUG] mac address : %s", mac))
+
+	p := url.Values{}
+	p.Set("macaddress", mac)
+	p.Set("port_name", d.Get("port_name").(string))
+	// an empty value takes the mac address off its device or vlan, so
+	// removing device_id or vlan_id is applied
+	if v := d.Get("device_id").(int); v != 0 {
+		p.Set("device_id", strconv.Itoa(v))
+	} else if d.HasChange("device_id") {
+		p.Set("device_id", "")
+	}
+	if v := d.Get("vlan_id").(int); v != 0 {
+		p.Set("vlan_id", strconv.Itoa(v))
+	} else if d.HasChange("vlan_id") {
+		p.Set("vlan_id", "")
+	}
+
+	id, err := apiPost(c, "/macs/", p)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create mac address " + mac,
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(id))
+
+	return resourceMACAddressRead(ctx, d, m)
+}
+
+func resourceMACAddressRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read id",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	mac, err := getMACAddressByID(c, id)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get mac address with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	log.Println(fmt.Sprintf("[DEBUG] mac address : %v", mac))
+
+	_ = d.Set("mac", macAddressStateFunc(mac.MAC))
+	_ = d.Set("vendor", mac.Vendor)
+	_ = d.Set("port_name", mac.PortName)
+	_ = d.Set("device_id", mac.DeviceID)
+	_ = d.Set("vlan_id", mac.VLANID)
+
+	return diags
+}
+
+// delete mac address
+func resourceMACAddressDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+	var diags diag.Diagnostics
+
+	err := apiDelete(c, "/macs/"+d.Id()+"/")
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete mac address with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// getMACAddressByID will return a mac address by id
+func getMACAddressByID(c *device42.API, id int) (*macAddress, error) {
+	macs := make([]macAddress, 0)
+	q := url.Values{"macaddress_id": {strconv.Itoa(id)}}
+	if err := apiGetList(c, "/macs/", q, "macaddresses", 0, &macs); err != nil {
+		return nil, err
+	}
+
+	for _, i := range macs {
+		if i.ID == id {
+			return &i, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find mac address with id %d", id)
+}
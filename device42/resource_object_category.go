@@ -0,0 +1,227 @@
+package device42
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// objectCategory type
+type objectCategory struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+func resourceObjectCategory() *schema.Resource {
+	return &schema.Resource{
+		Description:   "`device42_object_category` resource can be used to create, update or delete an object category.",
+		CreateContext: resourceObjectCategorySet,
+		ReadContext:   resourceObjectCategoryRead,
+		UpdateContext: resourceObjectCategorySet,
+		DeleteContext: resourceObjectCategoryDelete,
+		Schema: map[string]*schema.Schema{
+			"last_updated": &schema.Schema{
+				Description: "The last time this resource was updated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": &schema.Schema{
+				Description: "The `name` of the object category.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"description": &schema.Schema{
+				Description: "The `description` of the object category.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceObjectCategorySet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+
+	log.Println(fmt.Sprintf("[DEBUG] object category : %s", name))
+
+	id, err := apiPost(c, "/object_categories/", url.Values{
+		"name":        {name},
+		"description": {d.Get("description").(string)},
+	})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create object category with name " + name,
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(id))
+
+	return resourceObjectCategoryRead(ctx, d, m)
+}
+
+func resourceObjectCategoryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read id",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	category, err := getObjectCategoryByID(c, id)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get object category with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	log.Println(fmt.Sprintf("[DEBUG] object category : %v", category))
+
+	_ = d.Set("name", category.Name)
+	_ = d.Set("description", category.Description)
+
+	return diags
+}
+
+// delete object category
+func resourceObjectCategoryDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+	var diags diag.Diagnostics
+
+	err := apiDelete(c, "/object_categories/"+d.Id()+"/")
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete object category with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// getObjectCategories will return a list of all object categories
+func getObjectCategories(c *device42.API) ([]objectCategory, error) {
+	categories := make([]objectCategory, 0)
+	if err := apiGetList(c, "/object_categories/", url.Values{}, "object_categories", 0, &categories); err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+// getObjectCategoryByID will return an object category by id
+func getObjectCategoryByID(c *device42.API, id int) (*objectCategory, error) {
+	categories, err := getObjectCategories(c)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range categories {
+		if i.ID == id {
+			return &i, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find object category with id %d", id)
+}
+
+// getObjectCategoryByName will return an object category by name
+func getObjectCategoryByName(c *device42.API, name string) (*objectCategory, error) {
+	categories, err := getObjectCategories(c)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range categories {
+		if i.Name == name {
+			return &i, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find object category with name %s", name)
+}
+
+// objectCategorySchema is the `object_category` argument of resources that
+// can be scoped by an object category
+func objectCategorySchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "The name of the `object_category` this object belongs to.",
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+}
+
+// setObjectCategory will post the object category of an object. p identifies
+// the object to the endpoint at path.
+func setObjectCategory(c *device42.API, d *schema.ResourceData, method, path string, p url.Values) error {
+	if !d.HasChange("object_category") {
+		return nil
+	}
+
+	v := url.Values{}
+	for k, i := range p {
+		v[k] = i
+	}
+	v.Set("category", d.Get("object_category").(string))
+
+	_, err := apiSend(c, method, path, v)
+	return err
+}
+
+// getObjectCategoryName will return the object category name of an object
+// listed at path under listKey, where idKey holds the object's id. the list
+// is filtered on idKey, so only the object itself is read.
+func getObjectCategoryName(c *device42.API, path, listKey, idKey string, id int) (string, error) {
+	objects := make([]map[string]interface{}, 0)
+	q := url.Values{idKey: {strconv.Itoa(id)}}
+	if err := apiGetList(c, path, q, listKey, 0, &objects); err != nil {
+		return "", err
+	}
+
+	for _, o := range objects {
+		if v, ok := o[idKey].(float64); !ok || int(v) != id {
+			continue
+		}
+		// subnets call it category_name, everything else calls it category
+		for _, k := range []string{"category", "category_name"} {
+			if n, ok := o[k].(string); ok {
+				return n, nil
+			}
+		}
+		return "", nil
+	}
+
+	return "", fmt.Errorf("could not find %s with id %d", idKey, id)
+}
@@ -0,0 +1,260 @@
+package device42
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+	"strconv"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// patchPanel type
+type patchPanel struct {
+	ID            int     `json:"id"`
+	Name          string  `json:"name"`
+	NumberOfPorts int     `json:"number_of_ports"`
+	PortType      string  `json:"port_type"`
+	RackID        int     `json:"rack_id"`
+	StartAt       float64 `json:"start_at"`
+	Notes         string  `json:"notes"`
+}
+
+// patchPanelPort type
+type patchPanelPort struct {
+	ID           int `json:"id"`
+	PatchPanelID int `json:"patch_panel_id"`
+	Number       int `json:"number"`
+}
+
+func resourcePatchPanel() *schema.Resource {
+	return &schema.Resource{
+		Description:   "`device42_patch_panel` resource can be used to create, update or delete a patch panel.",
+		CreateContext: resourcePatchPanelSet,
+		ReadContext:   resourcePatchPanelRead,
+		UpdateContext: resourcePatchPanelSet,
+		DeleteContext: resourcePatchPanelDelete,
+		Schema: map[string]*schema.Schema{
+			"last_updated": &schema.Schema{
+				Description: "The last time this resource was updated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": &schema.Schema{
+				Description: "The `name` of the patch panel.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"number_of_ports": &schema.Schema{
+				Description:  "The `number_of_ports` of the patch panel.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"port_type": &schema.Schema{
+				Description: "The `port_type` of the patch panel. (e.g., RJ45 or LC)",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"rack_id": &schema.Schema{
+				Description: "The `rack_id` of the rack the patch panel is mounted in.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"start_at": &schema.Schema{
+				Description:  "The rack unit the patch panel starts at.",
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				RequiredWith: []string{"rack_id"},
+			},
+			"notes": &schema.Schema{
+				Description: "`notes` for the patch panel.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"ports": &schema.Schema{
+				Description: "The `ports` of the patch panel.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Description: "The `id` of the port.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"number": &schema.Schema{
+							Description: "The `number` of the port.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourcePatchPanelSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+
+	log.Println(fmt.Sprintf("[DEBUG] patch panel : %s", name))
+
+	p := url.Values{}
+	p.Set("name", name)
+	p.Set("number_of_ports", strconv.Itoa(d.Get("number_of_ports").(int)))
+	p.Set("port_type", d.Get("port_type").(string))
+	p.Set("notes", d.Get("notes").(string))
+	// an empty value takes the patch panel out of its rack or makes it zero
+	// U, so removing rack_id or start_at is applied
+	if v := d.Get("rack_id").(int); v != 0 {
+		p.Set("rack_id", strconv.Itoa(v))
+	} else if d.HasChange("rack_id") {
+		p.Set("rack_id", "")
+	}
+	if v := d.Get("start_at").(float64); v != 0 {
+		p.Set("start_at", strconv.FormatFloat(v, 'f', -1, 64))
+	} else if d.HasChange("start_at") {
+		p.Set("start_at", "")
+	}
+
+	id, err := apiPost(c, "/patch_panels/", p)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create patch panel with name " + name,
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(id))
+
+	return resourcePatchPanelRead(ctx, d, m)
+}
+
+func resourcePatchPanelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read id",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	panel, err := getPatchPanelByID(c, id)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get patch panel with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	log.Println(fmt.Sprintf("[DEBUG] patch panel : %v", panel))
+
+	ports, err := getPatchPanelPorts(c, id)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get ports of patch panel with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	_ = d.Set("name", panel.Name)
+	_ = d.Set("number_of_ports", panel.NumberOfPorts)
+	_ = d.Set("port_type", panel.PortType)
+	_ = d.Set("rack_id", panel.RackID)
+	_ = d.Set("start_at", panel.StartAt)
+	_ = d.Set("notes", panel.Notes)
+	_ = d.Set("ports", flattenPatchPanelPorts(ports))
+
+	return diags
+}
+
+// delete patch panel
+func resourcePatchPanelDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+	var diags diag.Diagnostics
+
+	err := apiDelete(c, "/patch_panels/"+d.Id()+"/")
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete patch panel with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// flatten patch panel ports to a map
+func flattenPatchPanelPorts(ports []patchPanelPort) []interface{} {
+	ps := make([]interface{}, len(ports))
+	for i, port := range ports {
+		ps[i] = map[string]interface{}{
+			"id":     port.ID,
+			"number": port.Number,
+		}
+	}
+	return ps
+}
+
+// getPatchPanelByID will return a patch panel by id
+func getPatchPanelByID(c *device42.API, id int) (*patchPanel, error) {
+	panels := make([]patchPanel, 0)
+	q := url.Values{"id": {strconv.Itoa(id)}}
+	if err := apiGetList(c, "/patch_panels/", q, "patch_panels", 0, &panels); err != nil {
+		return nil, err
+	}
+
+	for _, i := range panels {
+		if i.ID == id {
+			return &i, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find patch panel with id %d", id)
+}
+
+// getPatchPanelPorts will return the ports of a patch panel by port number
+func getPatchPanelPorts(c *device42.API, id int) ([]patchPanelPort, error) {
+	ports := make([]patchPanelPort, 0)
+	q := url.Values{"patch_panel_id": {strconv.Itoa(id)}}
+	if err := apiGetList(c, "/patch_panel_ports/", q, "patch_panel_ports", 0, &ports); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(ports, func(i, j int) bool { return ports[i].Number < ports[j].Number })
+
+	return ports, nil
+}
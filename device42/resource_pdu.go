@@ -0,0 +1,270 @@
+package device42
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+	"strconv"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// pdu type
+type pdu struct {
+	ID            int     `json:"pdu_id"`
+	Name          string  `json:"name"`
+	Model         string  `json:"pdu_model"`
+	RackID        int     `json:"rack_id"`
+	StartAt       float64 `json:"start_at"`
+	CapacityWatts int     `json:"capacity_watts"`
+	Notes         string  `json:"notes"`
+}
+
+// pduOutlet type
+type pduOutlet struct {
+	ID     int `json:"id"`
+	PDUID  int `json:"pdu_id"`
+	Number int `json:"number"`
+}
+
+func resourcePDU() *schema.Resource {
+	return &schema.Resource{
+		Description:   "`device42_pdu` resource can be used to create, update or delete a PDU.",
+		CreateContext: resourcePDUSet,
+		ReadContext:   resourcePDURead,
+		UpdateContext: resourcePDUSet,
+		DeleteContext: resourcePDUDelete,
+		Schema: map[string]*schema.Schema{
+			"last_updated": &schema.Schema{
+				Description: "The last time this resource was updated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": &schema.Schema{
+				Description: "The `name` of the PDU.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"model": &schema.Schema{
+				Description: "The name of the PDU `model`. The model decides the outlets of the PDU.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"rack_id": &schema.Schema{
+				Description: "The `rack_id` of the rack the PDU is placed in.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"start_at": &schema.Schema{
+				Description:  "The rack unit the PDU starts at. Leave unset for a zero U PDU.",
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				RequiredWith: []string{"rack_id"},
+			},
+			"capacity_watts": &schema.Schema{
+				Description:  "The `capacity_watts` of the PDU. Defaults to the capacity of the model.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"notes": &schema.Schema{
+				Description: "`notes` for the PDU.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"outlets": &schema.Schema{
+				Description: "The `outlets` of the PDU.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Description: "The `id` of the outlet.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"number": &schema.Schema{
+							Description: "The `number` of the outlet.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourcePDUSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+
+	log.Println(fmt.Sprintf("[DEBUG] pdu : %s", name))
+
+	p := url.Values{}
+	p.Set("name", name)
+	p.Set("pdu_model", d.Get("model").(string))
+	p.Set("notes", d.Get("notes").(string))
+	// an empty value takes the pdu out of its rack or makes it zero U, so
+	// removing rack_id or start_at is applied
+	if v := d.Get("rack_id").(int); v != 0 {
+		p.Set("rack_id", strconv.Itoa(v))
+	} else if d.HasChange("rack_id") {
+		p.Set("rack_id", "")
+	}
+	if v := d.Get("start_at").(float64); v != 0 {
+		p.Set("start_at", strconv.FormatFloat(v, 'f', -1, 64))
+	} else if d.HasChange("start_at") {
+		p.Set("start_at", "")
+	}
+	if v := d.Get("capacity_watts").(int); v != 0 {
+		p.Set("capacity_watts", strconv.Itoa(v))
+	}
+
+	id, err := apiPost(c, "/pdus/", p)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create pdu with name " + name,
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(id))
+
+	return resourcePDURead(ctx, d, m)
+}
+
+func resourcePDURead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read id",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	p, err := getPDUByID(c, id)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get pdu with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	log.Println(fmt.Sprintf("[DEBUG] pdu : %v", p))
+
+	outlets, err := getPDUOutlets(c, id)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get outlets of pdu with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	_ = d.Set("name", p.Name)
+	_ = d.Set("model", p.Model)
+	_ = d.Set("rack_id", p.RackID)
+	_ = d.Set("start_at", p.StartAt)
+	_ = d.Set("capacity_watts", p.CapacityWatts)
+	_ = d.Set("notes", p.Notes)
+	_ = d.Set("outlets", flattenPDUOutlets(outlets))
+
+	return diags
+}
+
+// delete pdu
+func resourcePDUDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+	var diags diag.Diagnostics
+
+	err := apiDelete(c, "/pdus/"+d.Id()+"/")
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete pdu with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// flatten pdu outlets to a map
+func flattenPDUOutlets(outlets []pduOutlet) []interface{} {
+	ol := make([]interface{}, len(outlets))
+	for i, outlet := range outlets {
+		ol[i] = map[string]interface{}{
+			"id":     outlet.ID,
+			"number": outlet.Number,
+		}
+	}
+	return ol
+}
+
+// getPDUs will return a list of pdus. q can filter on rack_id.
+func getPDUs(c *device42.API, q url.Values) ([]pdu, error) {
+	pdus := make([]pdu, 0)
+	if err := apiGetList(c, "/pdus/", q, "pdus", 0, &pdus); err != nil {
+		return nil, err
+	}
+
+	return pdus, nil
+}
+
+// getPDUByID will return a pdu by id
+func getPDUByID(c *device42.API, id int) (*pdu, error) {
+	pdus, err := getPDUs(c, url.Values{"pdu_id": {strconv.Itoa(id)}})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range pdus {
+		if i.ID == id {
+			return &i, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find pdu with id %d", id)
+}
+
+// getPDUOutlets will return the outlets of a pdu by outlet number
+func getPDUOutlets(c *device42.API, id int) ([]pduOutlet, error) {
+	outlets := make([]pduOutlet, 0)
+	q := url.Values{"pdu_id": {strconv.Itoa(id)}}
+	if err := apiGetList(c, "/pdu_ports/", q, "pdu_ports", 0, &outlets); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(outlets, func(i, j int) bool { return outlets[i].Number < outlets[j].Number })
+
+	return outlets, nil
+}
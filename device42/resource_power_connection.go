@@ -0,0 +1,258 @@
+package device42
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// powerConnection type
+type powerConnection struct {
+	ID             int    `json:"id"`
+	DeviceID       int    `json:"device_id"`
+	PSUName        string `json:"psu_name"`
+	PDUID          int    `json:"pdu_id"`
+	OutletNumber   int    `json:"outlet_number"`
+	AllocatedWatts int    `json:"allocated_watts"`
+}
+
+func resourcePowerConnection() *schema.Resource {
+	return &schema.Resource{
+		Description:   "`device42_power_connection` resource can be used to connect a power supply of a device to an outlet of a PDU.",
+		CreateContext: resourcePowerConnectionCreate,
+		ReadContext:   resourcePowerConnectionRead,
+		UpdateContext: resourcePowerConnectionUpdate,
+		DeleteContext: resourcePowerConnectionDelete,
+		Schema: map[string]*schema.Schema{
+			"last_updated": &schema.Schema{
+				Description: "The last time this resource was updated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"device_id": &schema.Schema{
+				Description: "The `device_id` of the powered device.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"psu_name": &schema.Schema{
+				Description: "The name of the power supply of the device. (e.g., PSU1)",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"pdu_id": &schema.Schema{
+				Description: "The `pdu_id` of the PDU.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"outlet_number": &schema.Schema{
+				Description:  "The number of the PDU outlet.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"allocated_watts": &schema.Schema{
+				Description:  "The power allocated to the connection in watts.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourcePowerConnectionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	pduID := d.Get("pdu_id").(int)
+	outletNumber := d.Get("outlet_number").(int)
+
+	outlets, err := getPDUOutlets(c, pduID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get outlets of pdu with id " + strconv.Itoa(pduID),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	found := false
+	for _, o := range outlets {
+		if o.Number == outletNumber {
+			found = true
+			break
+		}
+	}
+	if !found {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create power connection",
+			Detail:   fmt.Sprintf("pdu with id %d has no outlet %d", pduID, outletNumber),
+		})
+		return diags
+	}
+
+	connections, err := getPowerConnections(c, url.Values{"pdu_id": {strconv.Itoa(pduID)}})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get power connections of pdu with id " + strconv.Itoa(pduID),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	for _, i := range connections {
+		if i.PDUID == pduID && i.OutletNumber == outletNumber {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to create power connection",
+				Detail:   fmt.Sprintf("outlet %d of pdu with id %d is already used by power connection with id %d", outletNumber, pduID, i.ID),
+			})
+			return diags
+		}
+	}
+
+	p := powerConnectionParameters(d)
+	p.Set("device_id", strconv.Itoa(d.Get("device_id").(int)))
+	p.Set("psu_name", d.Get("psu_name").(string))
+	p.Set("pdu_id", strconv.Itoa(pduID))
+	p.Set("outlet_number", strconv.Itoa(outletNumber))
+
+	log.Println(fmt.Sprintf("[DEBUG] power connection : %s", p.Encode()))
+
+	id, err := apiPost(c, "/power_connections/", p)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("unable to create power connection to outlet %d of pdu with id %d", outletNumber, pduID),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(id))
+
+	return resourcePowerConnectionRead(ctx, d, m)
+}
+
+func resourcePowerConnectionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	_, err := apiPut(c, "/power_connections/"+d.Id()+"/", powerConnectionParameters(d))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to update power connection with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return resourcePowerConnectionRead(ctx, d, m)
+}
+
+func resourcePowerConnectionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read id",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	connection, err := getPowerConnectionByID(c, id)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get power connection with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	log.Println(fmt.Sprintf("[DEBUG] power connection : %v", connection))
+
+	_ = d.Set("device_id", connection.DeviceID)
+	_ = d.Set("psu_name", connection.PSUName)
+	_ = d.Set("pdu_id", connection.PDUID)
+	_ = d.Set("outlet_number", connection.OutletNumber)
+	_ = d.Set("allocated_watts", connection.AllocatedWatts)
+
+	return diags
+}
+
+// delete power connection
+func resourcePowerConnectionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+	var diags diag.Diagnostics
+
+	err := apiDelete(c, "/power_connections/"+d.Id()+"/")
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete power connection with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func powerConnectionParameters(d *schema.ResourceData) url.Values {
+	return url.Values{"allocated_watts": {strconv.Itoa(d.Get("allocated_watts").(int))}}
+}
+
+// getPowerConnections will return a list of power connections. q can filter
+// on pdu_id and device_id.
+func getPowerConnections(c *device42.API, q url.Values) ([]powerConnection, error) {
+	connections := make([]powerConnection, 0)
+	if err := apiGetList(c, "/power_connections/", q, "power_connections", 0, &connections); err != nil {
+		return nil, err
+	}
+
+	return connections, nil
+}
+
+// getPowerConnectionByID will return a power connection by id
+func getPowerConnectionByID(c *device42.API, id int) (*powerConnection, error) {
+	connections, err := getPowerConnections(c, url.Values{"id": {strconv.Itoa(id)}})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range connections {
+		if i.ID == id {
+			return &i, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find power connection with id %d", id)
+}
@@ -0,0 +1,424 @@
+package device42
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+	"strconv"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// purchase type
+type purchase struct {
+	ID           int                `json:"purchase_id"`
+	OrderNumber  string             `json:"order_no"`
+	Vendor       string             `json:"vendor"`
+	CostCenter   string             `json:"cost_center"`
+	PurchaseDate string             `json:"po_date"`
+	Notes        string             `json:"notes"`
+	LineItems    []purchaseLineItem `json:"line_items"`
+}
+
+// purchaseLineItem type. contracts, such as warranties, are line items of
+// type contract.
+type purchaseLineItem struct {
+	Number       int     `json:"line_no"`
+	Type         string  `json:"line_type"`
+	Description  string  `json:"line_notes"`
+	Quantity     int     `json:"line_quantity"`
+	Cost         float64 `json:"line_cost"`
+	DeviceIDs    []int   `json:"line_device_ids"`
+	AssetIDs     []int   `json:"line_asset_ids"`
+	ContractType string  `json:"line_contract_type"`
+	StartDate    string  `json:"line_start_date"`
+	EndDate      string  `json:"line_end_date"`
+}
+
+func resourcePurchase() *schema.Resource {
+	return &schema.Resource{
+		Description:   "`device42_purchase` resource can be used to create, update or delete a purchase and its line items. Contracts, such as warranties, are managed with `device42_contract`.",
+		CreateContext: resourcePurchaseSet,
+		ReadContext:   resourcePurchaseRead,
+		UpdateContext: resourcePurchaseSet,
+		DeleteContext: resourcePurchaseDelete,
+		CustomizeDiff: resourcePurchaseCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"last_updated": &schema.Schema{
+				Description: "The last time this resource was updated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"order_number": &schema.Schema{
+				Description: "The purchase `order_number`.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"vendor_id": &schema.Schema{
+				Description: "The `vendor_id` of the vendor of the purchase.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"cost_center": &schema.Schema{
+				Description: "The `cost_center` the purchase is charged to.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"purchase_date": &schema.Schema{
+				Description:  "The `purchase_date` of the purchase. (YYYY-MM-DD)",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDate,
+			},
+			"notes": &schema.Schema{
+				Description: "`notes` for the purchase.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"line_item": &schema.Schema{
+				Description: "The line items of the purchase.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"line_number": &schema.Schema{
+							Description:  "The `line_number` of the line item in the purchase. It must not be used by a `device42_contract` of the purchase.",
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"type": &schema.Schema{
+							Description:  "The `type` of the line item. (device, asset, software, service or other)",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"device", "asset", "software", "service", "other"}, false),
+						},
+						"description": &schema.Schema{
+							Description: "The `description` of the line item.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"quantity": &schema.Schema{
+							Description:  "The `quantity` of the line item.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"unit_cost": &schema.Schema{
+							Description:  "The cost of one unit of the line item.",
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatAtLeast(0),
+						},
+						"device_ids": &schema.Schema{
+							Description: "The ids of the devices the line item is for.",
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+						"asset_ids": &schema.Schema{
+							Description: "The ids of the assets the line item is for.",
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+					},
+				},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// every line item needs its own line number
+func resourcePurchaseCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	used := make(map[int]bool)
+	for _, i := range d.Get("line_item").([]interface{}) {
+		number := i.(map[string]interface{})["line_number"].(int)
+		if used[number] {
+			return fmt.Errorf("line_number %d is used by more than one line_item", number)
+		}
+		used[number] = true
+	}
+
+	return nil
+}
+
+func resourcePurchaseSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	orderNumber := d.Get("order_number").(string)
+
+	log.Println(fmt.Sprintf("[DEBUG] purchase : %s", orderNumber))
+
+	vendorID := d.Get("vendor_id").(int)
+	vendor, err := vendorNameByID(c, vendorID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get vendor with id " + strconv.Itoa(vendorID),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	id, err := apiPost(c, "/purchases/", url.Values{
+		"order_no":    {orderNumber},
+		"vendor":      {vendor},
+		"cost_center": {d.Get("cost_center").(string)},
+		"po_date":     {d.Get("purchase_date").(string)},
+		"notes":       {d.Get("notes").(string)},
+	})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create purchase with order number " + orderNumber,
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(id))
+
+	p, err := getPurchaseByID(c, id)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get purchase with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	items := expandPurchaseLineItems(d.Get("line_item").([]interface{}))
+	numbers := make([]int, len(items))
+	for n, i := range items {
+		numbers[n] = i.Number
+	}
+
+	// line numbers of contracts are managed by device42_contract
+	for _, i := range p.LineItems {
+		if i.Type == "contract" && intInSlice(i.Number, numbers) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to set line items of purchase with id " + d.Id(),
+				Detail:   fmt.Sprintf("line %d is already used by a contract", i.Number),
+			})
+			return diags
+		}
+	}
+
+	for _, i := range items {
+		if err := setPurchaseLineItem(c, orderNumber, i); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("unable to set line %d of purchase with id %d", i.Number, id),
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	// remove the line items that are no longer listed
+	for _, i := range p.LineItems {
+		if i.Type == "contract" || intInSlice(i.Number, numbers) {
+			continue
+		}
+		if err := deletePurchaseLineItem(c, id, i.Number); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("unable to delete line %d of purchase with id %d", i.Number, id),
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return resourcePurchaseRead(ctx, d, m)
+}
+
+func resourcePurchaseRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read id",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	p, err := getPurchaseByID(c, id)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get purchase with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	log.Println(fmt.Sprintf("[DEBUG] purchase : %v", p))
+
+	vendorID, err := vendorIDByName(c, p.Vendor)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get vendor with name " + p.Vendor,
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	_ = d.Set("order_number", p.OrderNumber)
+	_ = d.Set("vendor_id", vendorID)
+	_ = d.Set("cost_center", p.CostCenter)
+	_ = d.Set("purchase_date", p.PurchaseDate)
+	_ = d.Set("notes", p.Notes)
+
+	err = d.Set("line_item", flattenPurchaseLineItems(p.LineItems))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to set line items of purchase with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return diags
+}
+
+// delete purchase
+func resourcePurchaseDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+	var diags diag.Diagnostics
+
+	err := apiDelete(c, "/purchases/"+d.Id()+"/")
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete purchase with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// expand the line_item blocks of a purchase
+func expandPurchaseLineItems(l []interface{}) []purchaseLineItem {
+	items := make([]purchaseLineItem, 0, len(l))
+	for _, i := range l {
+		item := i.(map[string]interface{})
+		items = append(items, purchaseLineItem{
+			Number:      item["line_number"].(int),
+			Type:        item["type"].(string),
+			Description: item["description"].(string),
+			Quantity:    item["quantity"].(int),
+			Cost:        item["unit_cost"].(float64),
+			DeviceIDs:   interfaceSliceToIntSlice(item["device_ids"].(*schema.Set).List()),
+			AssetIDs:    interfaceSliceToIntSlice(item["asset_ids"].(*schema.Set).List()),
+		})
+	}
+	return items
+}
+
+// flatten the line items of a purchase, other than contracts, to a map
+func flattenPurchaseLineItems(items []purchaseLineItem) []interface{} {
+	sorted := make([]purchaseLineItem, 0, len(items))
+	for _, i := range items {
+		if i.Type != "contract" {
+			sorted = append(sorted, i)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Number < sorted[j].Number })
+
+	l := make([]interface{}, len(sorted))
+	for n, i := range sorted {
+		l[n] = map[string]interface{}{
+			"line_number": i.Number,
+			"type":        i.Type,
+			"description": i.Description,
+			"quantity":    i.Quantity,
+			"unit_cost":   i.Cost,
+			"device_ids":  i.DeviceIDs,
+			"asset_ids":   i.AssetIDs,
+		}
+	}
+	return l
+}
+
+// setPurchaseLineItem will add or update a line item of a purchase by its
+// line number
+func setPurchaseLineItem(c *device42.API, orderNumber string, item purchaseLineItem) error {
+	_, err := apiSend(c, "POST", "/purchases/", url.Values{
+		"order_no":           {orderNumber},
+		"line_no":            {strconv.Itoa(item.Number)},
+		"line_type":          {item.Type},
+		"line_notes":         {item.Description},
+		"line_quantity":      {strconv.Itoa(item.Quantity)},
+		"line_cost":          {strconv.FormatFloat(item.Cost, 'f', -1, 64)},
+		"line_device_ids":    {intsToCommaString(item.DeviceIDs)},
+		"line_asset_ids":     {intsToCommaString(item.AssetIDs)},
+		"line_contract_type": {item.ContractType},
+		"line_start_date":    {item.StartDate},
+		"line_end_date":      {item.EndDate},
+	})
+	return err
+}
+
+// deletePurchaseLineItem will delete a line item of a purchase by its line
+// number
+func deletePurchaseLineItem(c *device42.API, purchaseID, number int) error {
+	return apiDelete(c, fmt.Sprintf("/purchases/%d/line_items/%d/", purchaseID, number))
+}
+
+// getPurchases will return a list of purchases matching q
+func getPurchases(c *device42.API, q url.Values) ([]purchase, error) {
+	purchases := make([]purchase, 0)
+	if err := apiGetList(c, "/purchases/", q, "purchases", 0, &purchases); err != nil {
+		return nil, err
+	}
+
+	return purchases, nil
+}
+
+// getPurchaseByID will return a purchase by id
+func getPurchaseByID(c *device42.API, id int) (*purchase, error) {
+	purchases, err := getPurchases(c, url.Values{"purchase_id": {strconv.Itoa(id)}})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range purchases {
+		if i.ID == id {
+			return &i, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find purchase with id %d", id)
+}
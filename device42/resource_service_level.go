@@ -0,0 +1,163 @@
+package device42
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// serviceLevel type
+type serviceLevel struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func resourceServiceLevel() *schema.Resource {
+	return &schema.Resource{
+		Description:   "`device42_service_level` resource can be used to create or delete a service level.",
+		CreateContext: resourceServiceLevelSet,
+		ReadContext:   resourceServiceLevelRead,
+		DeleteContext: resourceServiceLevelDelete,
+		Schema: map[string]*schema.Schema{
+			"last_updated": &schema.Schema{
+				Description: "The last time this resource was updated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": &schema.Schema{
+				Description: "The `name` of the service level. (e.g., Production)",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceServiceLevelSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+
+	log.Println(fmt.Sprintf("[DEBUG] service level : %s", name))
+
+	id, err := apiPost(c, "/service_level/", url.Values{"name": {name}})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create service level with name " + name,
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(id))
+
+	return resourceServiceLevelRead(ctx, d, m)
+}
+
+func resourceServiceLevelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read id",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	level, err := getServiceLevelByID(c, id)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get service level with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	log.Println(fmt.Sprintf("[DEBUG] service level : %v", level))
+
+	_ = d.Set("name", level.Name)
+
+	return diags
+}
+
+// delete service level
+func resourceServiceLevelDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+	var diags diag.Diagnostics
+
+	err := apiDelete(c, "/service_level/"+d.Id()+"/")
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete service level with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// getServiceLevels will return a list of all service levels
+func getServiceLevels(c *device42.API) ([]serviceLevel, error) {
+	levels := make([]serviceLevel, 0)
+	if err := apiGetList(c, "/service_level/", url.Values{}, "service_levels", 0, &levels); err != nil {
+		return nil, err
+	}
+
+	return levels, nil
+}
+
+// getServiceLevelByID will return a service level by id
+func getServiceLevelByID(c *device42.API, id int) (*serviceLevel, error) {
+	levels, err := getServiceLevels(c)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range levels {
+		if i.ID == id {
+			return &i, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find service level with id %d", id)
+}
+
+// getServiceLevelByName will return a service level by name
+func getServiceLevelByName(c *device42.API, name string) (*serviceLevel, error) {
+	levels, err := getServiceLevels(c)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range levels {
+		if i.Name == name {
+			return &i, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find service level with name %s", name)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"net/url"
 	"strconv"
 
 	"github.com/chopnico/device42-go"
@@ -70,6 +71,17 @@ func resourceSubnet() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"vlan_ids": &schema.Schema{
+				Description: "The `vlan_ids` associated with this subnet. When unset, the VLANs already associated with the subnet are kept.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"object_category": objectCategorySchema(),
+			"custom_fields":   customFieldsResourceSchema(),
 		},
 	}
 }
@@ -115,6 +127,49 @@ func resourceSubnetSet(ctx context.Context, d *schema.ResourceData, m interface{
 
 	d.SetId(strconv.Itoa(subnet.SubnetID))
 
+	p := url.Values{
+		"network":   {subnet.Network},
+		"mask_bits": {strconv.Itoa(subnet.MaskBits)},
+	}
+	if subnet.VrfGroupID != 0 {
+		p.Set("vrf_group_id", strconv.Itoa(subnet.VrfGroupID))
+	}
+	if d.HasChange("vlan_ids") {
+		v := url.Values{"vlan_ids": {intsToCommaString(interfaceSliceToIntSlice(d.Get("vlan_ids").(*schema.Set).List()))}}
+		for k, i := range p {
+			v[k] = i
+		}
+		_, err = apiPost(c, "/subnets/", v)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to set VLANs for subnet with id " + d.Id(),
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	err = setObjectCategory(c, d, "POST", "/subnets/", p)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to set object category for subnet with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	err = setCustomFields(c, d, "subnet", url.Values{"subnet_id": {d.Id()}})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to set custom fields for subnet with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
 	resourceSubnetRead(ctx, d, m)
 
 	return diags
@@ -157,6 +212,24 @@ func resourceSubnetRead(ctx context.Context, d *schema.ResourceData, m interface
 	_ = d.Set("mask_bits", subnet.MaskBits)
 	_ = d.Set("vrf_group_id", subnet.VrfGroupID)
 	_ = d.Set("tags", subnet.Tags)
+	_ = d.Set("custom_fields", flattenManagedCustomFields(customFieldsFromInterface(subnet.CustomFields), d))
+
+	vlanIDs, err := getSubnetVLANIDs(c, subnetID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get VLANs for subnet with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	_ = d.Set("vlan_ids", vlanIDs)
+
+	if category, ok := subnet.CategoryName.(string); ok {
+		_ = d.Set("object_category", category)
+	} else {
+		_ = d.Set("object_category", "")
+	}
 
 	return diags
 }
@@ -192,3 +265,50 @@ func resourceSubnetDelete(ctx context.Context, d *schema.ResourceData, m interfa
 
 	return diags
 }
+
+// subnetVLANs type is the VLANs of a subnet as the appliance lists them
+type subnetVLANs struct {
+	SubnetID     int   `json:"subnet_id"`
+	VLANIDs      []int `json:"vlan_ids"`
+	ParentVLANID int   `json:"parent_vlan_id"`
+}
+
+// getSubnetVLANIDs will return the ids of the VLANs associated with a subnet.
+// appliances that don't list vlan_ids only report the parent VLAN.
+func getSubnetVLANIDs(c *device42.API, id int) ([]int, error) {
+	subnets := make([]subnetVLANs, 0)
+	q := url.Values{"subnet_id": {strconv.Itoa(id)}}
+	if err := apiGetList(c, "/subnets/", q, "subnets", 0, &subnets); err != nil {
+		return nil, err
+	}
+
+	for _, s := range subnets {
+		if s.SubnetID != id {
+			continue
+		}
+		if len(s.VLANIDs) > 0 {
+			return s.VLANIDs, nil
+		}
+		if s.ParentVLANID != 0 {
+			return []int{s.ParentVLANID}, nil
+		}
+		return []int{}, nil
+	}
+
+	return nil, fmt.Errorf("could not find subnet with id %d", id)
+}
+
+// getSubnetIDsByVLANID will return the ids of the subnets associated with a
+// vlan. unlike GetSubnetsByVlanID, no subnets is not an error.
+func getSubnetIDsByVLANID(c *device42.API, id int) ([]int, error) {
+	subnets, err := getSubnets(c, url.Values{"vlan_id": {strconv.Itoa(id)}}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, len(*subnets))
+	for i, s := range *subnets {
+		ids[i] = s.SubnetID
+	}
+	return ids, nil
+}
@@ -0,0 +1,208 @@
+package device42
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// switchPort type
+type switchPort struct {
+	ID           int    `json:"switchport_id"`
+	Port         string `json:"port"`
+	Type         string `json:"type"`
+	Description  string `json:"description"`
+	SwitchID     int    `json:"switch_id"`
+	VLANIDs      []int  `json:"vlan_ids"`
+	RemotePortID int    `json:"remote_port_id"`
+}
+
+func resourceSwitchPort() *schema.Resource {
+	return &schema.Resource{
+		Description:   "`device42_switch_port` resource can be used to create, update or delete a port of a switch.",
+		CreateContext: resourceSwitchPortSet,
+		ReadContext:   resourceSwitchPortRead,
+		UpdateContext: resourceSwitchPortSet,
+		DeleteContext: resourceSwitchPortDelete,
+		Schema: map[string]*schema.Schema{
+			"last_updated": &schema.Schema{
+				Description: "The last time this resource was updated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"switch_id": &schema.Schema{
+				Description: "The device id of the switch the port belongs to.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"port": &schema.Schema{
+				Description: "The name of the `port`. (e.g., Gi1/0/1)",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"type": &schema.Schema{
+				Description: "The `type` of the port. (e.g., ethernetCsmacd)",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": &schema.Schema{
+				Description: "The `description` of the port.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"vlan_ids": &schema.Schema{
+				Description: "The ids of the VLANs on the port. When not set, the VLANs device42 has for the port are kept.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"remote_port_id": &schema.Schema{
+				Description: "The id of the switch port this port is connected to.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceSwitchPortSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	port := d.Get("port").(string)
+	switchID := d.Get("switch_id").(int)
+
+	log.Println(fmt.Sprintf("[DEBUG] switch port : %d %s", switchID, port))
+
+	p := url.Values{}
+	p.Set("switch_id", strconv.Itoa(switchID))
+	p.Set("port", port)
+	p.Set("description", d.Get("description").(string))
+	if v := d.Get("type").(string); v != "" {
+		p.Set("type", v)
+	}
+	if v, ok := d.GetOk("vlan_ids"); ok || d.HasChange("vlan_ids") {
+		p.Set("vlan_ids", intsToCommaString(interfaceSliceToIntSlice(v.(*schema.Set).List())))
+	}
+	// an empty value disconnects the port, so removing remote_port_id is
+	// applied
+	if v := d.Get("remote_port_id").(int); v != 0 {
+		p.Set("remote_port_id", strconv.Itoa(v))
+	} else if d.HasChange("remote_port_id") {
+		p.Set("remote_port_id", "")
+	}
+
+	id, err := apiPost(c, "/switchports/", p)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create switch port " + port + " on switch with id " + strconv.Itoa(switchID),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(id))
+
+	return resourceSwitchPortRead(ctx, d, m)
+}
+
+func resourceSwitchPortRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read id",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	port, err := getSwitchPortByID(c, id)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get switch port with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	log.Println(fmt.Sprintf("[DEBUG] switch port : %v", port))
+
+	_ = d.Set("switch_id", port.SwitchID)
+	_ = d.Set("port", port.Port)
+	_ = d.Set("type", port.Type)
+	_ = d.Set("description", port.Description)
+	_ = d.Set("vlan_ids", port.VLANIDs)
+	_ = d.Set("remote_port_id", port.RemotePortID)
+
+	return diags
+}
+
+// delete switch port
+func resourceSwitchPortDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+	var diags diag.Diagnostics
+
+	err := apiDelete(c, "/switchports/"+d.Id()+"/")
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete switch port with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// getSwitchPorts will return a list of switch ports
+func getSwitchPorts(c *device42.API, q url.Values) ([]switchPort, error) {
+	ports := make([]switchPort, 0)
+	if err := apiGetList(c, "/switchports/", q, "switchports", 0, &ports); err != nil {
+		return nil, err
+	}
+
+	return ports, nil
+}
+
+// getSwitchPortByID will return a switch port by id
+func getSwitchPortByID(c *device42.API, id int) (*switchPort, error) {
+	ports, err := getSwitchPorts(c, url.Values{"switchport_id": {strconv.Itoa(id)}})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range ports {
+		if i.ID == id {
+			return &i, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find switch port with id %d", id)
+}
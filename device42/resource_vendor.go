@@ -0,0 +1,228 @@
+package device42
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// vendor type
+type vendor struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	HomePage string `json:"home_page"`
+	Phone    string `json:"phone"`
+	Email    string `json:"email"`
+	Notes    string `json:"notes"`
+}
+
+func resourceVendor() *schema.Resource {
+	return &schema.Resource{
+		Description:   "`device42_vendor` resource can be used to create, update or delete a vendor.",
+		CreateContext: resourceVendorSet,
+		ReadContext:   resourceVendorRead,
+		UpdateContext: resourceVendorSet,
+		DeleteContext: resourceVendorDelete,
+		Schema: map[string]*schema.Schema{
+			"last_updated": &schema.Schema{
+				Description: "The last time this resource was updated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": &schema.Schema{
+				Description: "The `name` of the vendor.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"home_page": &schema.Schema{
+				Description: "The `home_page` of the vendor.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"phone": &schema.Schema{
+				Description: "The `phone` number of the vendor.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"email": &schema.Schema{
+				Description: "The `email` address of the vendor.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"notes": &schema.Schema{
+				Description: "`notes` for the vendor.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceVendorSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+
+	log.Println(fmt.Sprintf("[DEBUG] vendor : %s", name))
+
+	id, err := apiPost(c, "/vendors/", url.Values{
+		"name":      {name},
+		"home_page": {d.Get("home_page").(string)},
+		"phone":     {d.Get("phone").(string)},
+		"email":     {d.Get("email").(string)},
+		"notes":     {d.Get("notes").(string)},
+	})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create vendor with name " + name,
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(id))
+
+	return resourceVendorRead(ctx, d, m)
+}
+
+func resourceVendorRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read id",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	v, err := getVendorByID(c, id)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get vendor with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	log.Println(fmt.Sprintf("[DEBUG] vendor : %v", v))
+
+	_ = d.Set("name", v.Name)
+	_ = d.Set("home_page", v.HomePage)
+	_ = d.Set("phone", v.Phone)
+	_ = d.Set("email", v.Email)
+	_ = d.Set("notes", v.Notes)
+
+	return diags
+}
+
+// delete vendor
+func resourceVendorDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+	var diags diag.Diagnostics
+
+	err := apiDelete(c, "/vendors/"+d.Id()+"/")
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete vendor with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// getVendors will return a list of vendors matching q
+func getVendors(c *device42.API, q url.Values) ([]vendor, error) {
+	vendors := make([]vendor, 0)
+	if err := apiGetList(c, "/vendors/", q, "vendors", 0, &vendors); err != nil {
+		return nil, err
+	}
+
+	return vendors, nil
+}
+
+// getVendorByID will return a vendor by id
+func getVendorByID(c *device42.API, id int) (*vendor, error) {
+	vendors, err := getVendors(c, url.Values{"id": {strconv.Itoa(id)}})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range vendors {
+		if i.ID == id {
+			return &i, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find vendor with id %d", id)
+}
+
+// getVendorByName will return a vendor by name
+func getVendorByName(c *device42.API, name string) (*vendor, error) {
+	vendors, err := getVendors(c, url.Values{"name": {name}})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range vendors {
+		if i.Name == name {
+			return &i, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find vendor with name %s", name)
+}
+
+// vendorNameByID will return the name of a vendor by id, or an empty name
+// when id is 0. the appliance takes vendors of other objects by name.
+func vendorNameByID(c *device42.API, id int) (string, error) {
+	if id == 0 {
+		return "", nil
+	}
+
+	v, err := getVendorByID(c, id)
+	if err != nil {
+		return "", err
+	}
+
+	return v.Name, nil
+}
+
+// vendorIDByName will return the id of a vendor by name, or 0 when name is
+// empty
+func vendorIDByName(c *device42.API, name string) (int, error) {
+	if name == "" {
+		return 0, nil
+	}
+
+	v, err := getVendorByName(c, name)
+	if err != nil {
+		return 0, err
+	}
+
+	return v.ID, nil
+}
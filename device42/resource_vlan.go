@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/url"
 	"strconv"
 
 	"github.com/chopnico/device42-go"
@@ -35,6 +36,24 @@ func resourceVLAN() *schema.Resource {
 				Type:        schema.TypeInt,
 				Required:    true,
 			},
+			"description": &schema.Schema{
+				Description: "The `description` of the VLAN.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"notes": &schema.Schema{
+				Description: "`notes` for the VLAN.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"switch_ids": &schema.Schema{
+				Description: "The device ids of the `switch_ids` this VLAN is bound to.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
 			"tags": &schema.Schema{
 				Description: "The `tags` for this VLAN.",
 				Type:        schema.TypeList,
@@ -43,6 +62,8 @@ func resourceVLAN() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"object_category": objectCategorySchema(),
+			"custom_fields":   customFieldsResourceSchema(),
 		},
 	}
 }
@@ -58,9 +79,11 @@ func resourceVLANSet(ctx context.Context, d *schema.ResourceData, m interface{})
 	tags := interfaceSliceToStringSlice(d.Get("tags").([]interface{}))
 
 	vlan, err := c.SetVLAN(&device42.VLAN{
-		Name:   d.Get("name").(string),
-		Number: d.Get("number").(int),
-		Tags:   tags,
+		Name:        d.Get("name").(string),
+		Number:      d.Get("number").(int),
+		Description: d.Get("description").(string),
+		Notes:       d.Get("notes").(string),
+		Tags:        tags,
 	})
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
@@ -74,6 +97,39 @@ func resourceVLANSet(ctx context.Context, d *schema.ResourceData, m interface{})
 
 	d.SetId(strconv.Itoa(vlan.VlanID))
 
+	if d.HasChange("switch_ids") {
+		switchIDs := interfaceSliceToIntSlice(d.Get("switch_ids").(*schema.Set).List())
+		_, err = apiPut(c, "/vlans/"+d.Id()+"/", url.Values{"switch_ids": {intsToCommaString(switchIDs)}})
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to set switches for VLAN with id " + d.Id(),
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	err = setObjectCategory(c, d, "PUT", "/vlans/"+d.Id()+"/", url.Values{})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to set object category for VLAN with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	err = setCustomFields(c, d, "vlan", url.Values{"id": {d.Id()}})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to set custom fields for VLAN with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
 	resourceVLANRead(ctx, d, m)
 
 	return diags
@@ -108,7 +164,32 @@ func resourceVLANRead(ctx context.Context, d *schema.ResourceData, m interface{}
 
 	_ = d.Set("name", vlan.Name)
 	_ = d.Set("number", vlan.Number)
+	_ = d.Set("description", vlan.Description)
+	_ = d.Set("notes", vlan.Notes)
 	_ = d.Set("tags", vlan.Tags)
+	_ = d.Set("switch_ids", vlanSwitchIDs(vlan))
+
+	customFields, err := getVLANCustomFields(c, vlanID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get custom fields for VLAN with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	_ = d.Set("custom_fields", flattenManagedCustomFields(customFields, d))
+
+	category, err := getObjectCategoryName(c, "/vlans/", "vlans", "vlan_id", vlanID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get object category for VLAN with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	_ = d.Set("object_category", category)
 
 	return diags
 }
@@ -144,3 +225,12 @@ func resourceVLANDelete(ctx context.Context, d *schema.ResourceData, m interface
 
 	return diags
 }
+
+// vlanSwitchIDs will return the device ids of the switches a vlan is bound to
+func vlanSwitchIDs(vlan *device42.VLAN) []int {
+	ids := make([]int, len(vlan.Switches))
+	for i, s := range vlan.Switches {
+		ids[i] = s.DeviceID
+	}
+	return ids
+}
@@ -0,0 +1,219 @@
+package device42
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+
+	device42 "github.com/chopnico/device42-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// vrf type
+type vrf struct {
+	ID                 int      `json:"id"`
+	Name               string   `json:"name"`
+	RouteDistinguisher string   `json:"rd"`
+	ImportRouteTargets []string `json:"import_rts"`
+	ExportRouteTargets []string `json:"export_rts"`
+	Description        string   `json:"description"`
+	VRFGroupID         int      `json:"vrf_group_id"`
+}
+
+func resourceVRF() *schema.Resource {
+	return &schema.Resource{
+		Description:   "`device42_vrf` resource can be used to create, update or delete a VRF.",
+		CreateContext: resourceVRFSet,
+		ReadContext:   resourceVRFRead,
+		UpdateContext: resourceVRFSet,
+		DeleteContext: resourceVRFDelete,
+		Schema: map[string]*schema.Schema{
+			"last_updated": &schema.Schema{
+				Description: "The last time this resource was updated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": &schema.Schema{
+				Description: "The `name` of the VRF.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"route_distinguisher": &schema.Schema{
+				Description:  "The `route_distinguisher` of the VRF. (ASN:nn or IP:nn)",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRouteDistinguisher,
+			},
+			"import_route_targets": &schema.Schema{
+				Description: "The `import_route_targets` of the VRF. (ASN:nn or IP:nn)",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateRouteDistinguisher,
+				},
+			},
+			"export_route_targets": &schema.Schema{
+				Description: "The `export_route_targets` of the VRF. (ASN:nn or IP:nn)",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateRouteDistinguisher,
+				},
+			},
+			"description": &schema.Schema{
+				Description: "The `description` of the VRF.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"vrf_group_id": &schema.Schema{
+				Description: "The `vrf_group_id` of the VRF group this VRF belongs to.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceVRFSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+
+	log.Println(fmt.Sprintf("[DEBUG] vrf : %s", name))
+
+	p := url.Values{}
+	p.Set("name", name)
+	p.Set("rd", d.Get("route_distinguisher").(string))
+	p.Set("import_rts", strings.Join(interfaceSliceToStringSlice(d.Get("import_route_targets").(*schema.Set).List()), ","))
+	p.Set("export_rts", strings.Join(interfaceSliceToStringSlice(d.Get("export_route_targets").(*schema.Set).List()), ","))
+	p.Set("description", d.Get("description").(string))
+	if v := d.Get("vrf_group_id").(int); v != 0 {
+		p.Set("vrf_group_id", strconv.Itoa(v))
+	}
+
+	id, err := apiPost(c, "/vrfs/", p)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create vrf with name " + name,
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(id))
+
+	return resourceVRFRead(ctx, d, m)
+}
+
+func resourceVRFRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+
+	var diags diag.Diagnostics
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read id",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	v, err := getVRFByID(c, id)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get vrf with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	log.Println(fmt.Sprintf("[DEBUG] vrf : %v", v))
+
+	_ = d.Set("name", v.Name)
+	_ = d.Set("route_distinguisher", v.RouteDistinguisher)
+	_ = d.Set("import_route_targets", v.ImportRouteTargets)
+	_ = d.Set("export_route_targets", v.ExportRouteTargets)
+	_ = d.Set("description", v.Description)
+	_ = d.Set("vrf_group_id", v.VRFGroupID)
+
+	return diags
+}
+
+// delete vrf
+func resourceVRFDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*device42.API)
+	var diags diag.Diagnostics
+
+	err := apiDelete(c, "/vrfs/"+d.Id()+"/")
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete vrf with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// getVRFs will return a list of vrfs matching q
+func getVRFs(c *device42.API, q url.Values) ([]vrf, error) {
+	list := make([]vrf, 0)
+	if err := apiGetList(c, "/vrfs/", q, "vrfs", 0, &list); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// getVRFByID will return a vrf by id
+func getVRFByID(c *device42.API, id int) (*vrf, error) {
+	list, err := getVRFs(c, url.Values{"id": {strconv.Itoa(id)}})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range list {
+		if i.ID == id {
+			return &i, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find vrf with id %d", id)
+}
+
+// getVRFByName will return a vrf by name
+func getVRFByName(c *device42.API, name string) (*vrf, error) {
+	list, err := getVRFs(c, url.Values{"name": {name}})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range list {
+		if i.Name == name {
+			return &i, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find vrf with name %s", name)
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/url"
 	"strconv"
+	"strings"
 
 	"github.com/chopnico/device42-go"
 
@@ -12,6 +14,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// vrfGroup type adds the fields of a vrf group the device42 client doesn't
+// decode
+type vrfGroup struct {
+	device42.VRFGroup
+	Notes        string        `json:"notes"`
+	Tags         []string      `json:"tags"`
+	CustomFields []customField `json:"custom_fields"`
+}
+
 func resourceVRFGroup() *schema.Resource {
 	return &schema.Resource{
 		Description:   "`device42_vrf_group` resource can be used to create, update, or delete a VRF group.",
@@ -26,9 +37,10 @@ func resourceVRFGroup() *schema.Resource {
 				Computed:    true,
 			},
 			"name": &schema.Schema{
-				Description: "The `name` of the VRF group.",
+				Description: "The `name` of the VRF group. The appliance finds VRF groups by name, so changing it replaces the VRF group.",
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 			},
 			"description": &schema.Schema{
 				Description: "The `description` of the VRF group.",
@@ -36,13 +48,40 @@ func resourceVRFGroup() *schema.Resource {
 				Optional:    true,
 			},
 			"building_ids": &schema.Schema{
-				Description: "The `building_ids` of the VRF group.",
+				Description:   "The `building_ids` of the VRF group.",
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"building_names"},
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"building_names": &schema.Schema{
+				Description:   "The `building_names` of the VRF group. Can be used instead of `building_ids`.",
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"building_ids"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"notes": &schema.Schema{
+				Description: "`notes` for the VRF group.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"tags": &schema.Schema{
+				Description: "The `tags` for the VRF group.",
 				Type:        schema.TypeList,
 				Optional:    true,
 				Elem: &schema.Schema{
-					Type: schema.TypeInt,
+					Type: schema.TypeString,
 				},
 			},
+			"custom_fields": customFieldsResourceSchema(),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVRFGroupImport,
 		},
 	}
 }
@@ -53,43 +92,64 @@ func resourceVRFGroupSet(ctx context.Context, d *schema.ResourceData, m interfac
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	log.Println(fmt.Sprintf("[DEBUG] buildings : %s", d.Get("buildings")))
+	name := d.Get("name").(string)
+
+	buildings, err := vrfGroupBuildingNames(c, d)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get buildings for vrf group with name " + name,
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	log.Println(fmt.Sprintf("[DEBUG] buildings : %v", buildings))
 
-	buildings := make([]string, len(d.Get("building_ids").([]interface{})))
+	p := url.Values{}
+	p.Set("name", name)
+	p.Set("description", d.Get("description").(string))
+	p.Set("buildings", strings.Join(buildings, ","))
+	p.Set("notes", d.Get("notes").(string))
+	p.Set("tags", strings.Join(interfaceSliceToStringSlice(d.Get("tags").([]interface{})), ","))
 
-	for i, v := range d.Get("building_ids").([]interface{}) {
-		b, err := c.GetBuildingByID(v.(int))
+	id, err := apiSend(c, "POST", "/vrfgroup/", p)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create vrf group with name " + name,
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	// the vrf group endpoint doesn't always respond with an id
+	if id == 0 {
+		vrfGroup, err := c.GetVRFGroupByName(name)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
-				Summary:  "unable to get building with id " + strconv.Itoa(v.(int)),
+				Summary:  "unable to get vrf group with name " + name,
 				Detail:   err.Error(),
 			})
 			return diags
 		}
-		buildings[i] = (*b).Name
+		id = vrfGroup.ID
 	}
 
-	vrfGroup, err := c.SetVRFGroup(&device42.VRFGroup{
-		Name:        d.Get("name").(string),
-		Description: d.Get("description").(string),
-		Buildings:   buildings,
-	})
+	d.SetId(strconv.Itoa(id))
+
+	err = setCustomFields(c, d, "vrfgroup", url.Values{"id": {d.Id()}})
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
-			Summary:  "unable to create vrf group with name " + d.Get("name").(string),
+			Summary:  "unable to set custom fields for vrf group with id " + d.Id(),
 			Detail:   err.Error(),
 		})
+		return diags
 	}
 
-	log.Println(fmt.Sprintf("[DEBUG] vrf group : %v", vrfGroup))
-
-	d.SetId(strconv.Itoa(vrfGroup.ID))
-
-	resourceVRFGroupRead(ctx, d, m)
-
-	return diags
+	return resourceVRFGroupRead(ctx, d, m)
 }
 
 func resourceVRFGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -107,7 +167,7 @@ func resourceVRFGroupRead(ctx context.Context, d *schema.ResourceData, m interfa
 		})
 		return diags
 	}
-	vrfGroup, err := c.GetVRFGroupByID(vrfGroupID)
+	vrfGroup, err := getVRFGroupByID(c, vrfGroupID)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -119,9 +179,28 @@ func resourceVRFGroupRead(ctx context.Context, d *schema.ResourceData, m interfa
 
 	log.Println(fmt.Sprintf("[DEBUG] vrf group : %v", vrfGroup))
 
+	buildingIDs, err := getBuildingIDsByName(c, vrfGroup.Buildings)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get buildings for vrf group with id " + d.Id(),
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
 	_ = d.Set("name", vrfGroup.Name)
 	_ = d.Set("description", vrfGroup.Description)
-	_ = d.Set("buildings", vrfGroup.Buildings)
+	// only the attribute the buildings are configured with is read, so the
+	// other one doesn't show a diff
+	if d.Get("building_names").(*schema.Set).Len() > 0 {
+		_ = d.Set("building_names", vrfGroup.Buildings)
+	} else {
+		_ = d.Set("building_ids", buildingIDs)
+	}
+	_ = d.Set("notes", vrfGroup.Notes)
+	_ = d.Set("tags", vrfGroup.Tags)
+	_ = d.Set("custom_fields", flattenManagedCustomFields(vrfGroup.CustomFields, d))
 
 	return diags
 }
@@ -157,3 +236,104 @@ func resourceVRFGroupDelete(ctx context.Context, d *schema.ResourceData, m inter
 
 	return diags
 }
+
+// import a vrf group by id or by name
+func resourceVRFGroupImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*device42.API)
+
+	if _, err := strconv.Atoi(d.Id()); err == nil {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	vrfGroup, err := c.GetVRFGroupByName(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("unable to get vrf group with name %s : %s", d.Id(), err.Error())
+	}
+	d.SetId(strconv.Itoa(vrfGroup.ID))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// vrfGroupBuildingNames will return the names of the buildings of a vrf
+// group, which is what the appliance expects. building ids are resolved with
+// a single request, and no buildings clears them.
+func vrfGroupBuildingNames(c *device42.API, d *schema.ResourceData) ([]string, error) {
+	if names := d.Get("building_names").(*schema.Set); names.Len() > 0 {
+		return interfaceSliceToStringSlice(names.List()), nil
+	}
+
+	ids := interfaceSliceToIntSlice(d.Get("building_ids").(*schema.Set).List())
+	if len(ids) == 0 {
+		return []string{}, nil
+	}
+
+	buildings, err := getBuildings(c)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(ids))
+	for _, id := range ids {
+		found := false
+		for _, b := range buildings {
+			if b.BuildingID == id {
+				names = append(names, b.Name)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("could not find building with id %d", id)
+		}
+	}
+
+	return names, nil
+}
+
+// getBuildings will return every building
+func getBuildings(c *device42.API) ([]device42.Building, error) {
+	buildings := make([]device42.Building, 0)
+	if err := apiGetList(c, "/buildings/", url.Values{}, "buildings", 0, &buildings); err != nil {
+		return nil, err
+	}
+
+	return buildings, nil
+}
+
+// getBuildingIDsByName will return the ids of buildings by name, reading
+// the buildings once
+func getBuildingIDsByName(c *device42.API, names []string) ([]int, error) {
+	ids := make([]int, 0, len(names))
+	if len(names) == 0 {
+		return ids, nil
+	}
+
+	buildings, err := getBuildings(c)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, b := range buildings {
+		if stringInSlice(b.Name, names) {
+			ids = append(ids, b.BuildingID)
+		}
+	}
+
+	return ids, nil
+}
+
+// getVRFGroupByID will return a vrf group by id
+func getVRFGroupByID(c *device42.API, id int) (*vrfGroup, error) {
+	groups := make([]vrfGroup, 0)
+	if err := apiGetList(c, "/vrfgroup/", url.Values{}, "vrfgroup", 0, &groups); err != nil {
+		return nil, err
+	}
+
+	for _, v := range groups {
+		if v.ID == id {
+			return &v, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find vrf group with id %d", id)
+}
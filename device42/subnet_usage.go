@@ -0,0 +1,192 @@
+package device42
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strconv"
+
+	device42 "github.com/chopnico/device42-go"
+)
+
+// ipv4Range is an inclusive range of IPv4 addresses
+type ipv4Range struct {
+	start uint32
+	end   uint32
+}
+
+// subnetUsage type
+type subnetUsage struct {
+	Total     int
+	Used      int
+	Free      int
+	FreeRange []ipv4Range
+}
+
+// UtilizationPercent returns the share of used addresses
+func (u subnetUsage) UtilizationPercent() float64 {
+	if u.Total == 0 {
+		return 0
+	}
+	return float64(u.Used) / float64(u.Total) * 100
+}
+
+func ipv4ToUint32(ip net.IP) (uint32, bool) {
+	ip4 := ip.To4()
+	if ip4 == nil {
+		return 0, false
+	}
+	return binary.BigEndian.Uint32(ip4), true
+}
+
+func uint32ToIPv4(i uint32) string {
+	ip := make(net.IP, 4)
+	binary.BigEndian.PutUint32(ip, i)
+	return ip.String()
+}
+
+// subnetRange returns the assignable range of an IPv4 subnet
+func subnetRange(subnet *device42.Subnet) (ipv4Range, error) {
+	_, ipNet, err := net.ParseCIDR(subnet.Network + "/" + strconv.Itoa(subnet.MaskBits))
+	if err != nil {
+		return ipv4Range{}, err
+	}
+
+	start, ok := ipv4ToUint32(ipNet.IP)
+	if !ok {
+		return ipv4Range{}, fmt.Errorf("%s/%d is not an IPv4 subnet", subnet.Network, subnet.MaskBits)
+	}
+
+	ones, bits := ipNet.Mask.Size()
+	r := ipv4Range{start: start, end: start + uint32(uint64(1)<<uint(bits-ones)-1)}
+
+	// /31 and /32 have no network or broadcast address
+	if ones < 31 {
+		if subnet.AllowNetworkAddress != "yes" {
+			r.start++
+		}
+		if subnet.AllowBroadcastAddress != "yes" {
+			r.end--
+		}
+	}
+
+	return r, nil
+}
+
+// calculateSubnetUsage will work out how much of a subnet is used by its IPs
+// and its child subnets, and which ranges are still free
+func calculateSubnetUsage(subnet *device42.Subnet, ips []device42.IP, children []device42.Subnet) (subnetUsage, error) {
+	usage := subnetUsage{}
+
+	r, err := subnetRange(subnet)
+	if err != nil {
+		return usage, err
+	}
+	usage.Total = int(r.end-r.start) + 1
+
+	used := make([]ipv4Range, 0, len(ips)+len(children))
+	for _, ip := range ips {
+		if ip.Available == "yes" {
+			continue
+		}
+		a, ok := ipv4ToUint32(net.ParseIP(ip.Address))
+		if !ok || a < r.start || a > r.end {
+			continue
+		}
+		used = append(used, ipv4Range{start: a, end: a})
+	}
+	for _, child := range children {
+		_, ipNet, err := net.ParseCIDR(child.Network + "/" + strconv.Itoa(child.MaskBits))
+		if err != nil {
+			continue
+		}
+		start, ok := ipv4ToUint32(ipNet.IP)
+		if !ok {
+			continue
+		}
+		ones, bits := ipNet.Mask.Size()
+		c := ipv4Range{start: start, end: start + uint32(uint64(1)<<uint(bits-ones)-1)}
+		if c.start < r.start {
+			c.start = r.start
+		}
+		if c.end > r.end {
+			c.end = r.end
+		}
+		if c.start <= c.end {
+			used = append(used, c)
+		}
+	}
+
+	sort.Slice(used, func(i, j int) bool { return used[i].start < used[j].start })
+
+	next := uint64(r.start)
+	for _, u := range used {
+		if uint64(u.start) > next {
+			usage.FreeRange = append(usage.FreeRange, ipv4Range{start: uint32(next), end: u.start - 1})
+			usage.Free += int(uint64(u.start) - next)
+		}
+		if uint64(u.end)+1 > next {
+			next = uint64(u.end) + 1
+		}
+	}
+	if next <= uint64(r.end) {
+		usage.FreeRange = append(usage.FreeRange, ipv4Range{start: uint32(next), end: r.end})
+		usage.Free += int(uint64(r.end) - next + 1)
+	}
+
+	usage.Used = usage.Total - usage.Free
+
+	return usage, nil
+}
+
+// getSubnetsByParentSubnetID will return the child subnets of a subnet.
+// unlike GetSubnetsByParentSubnetID, no subnets is not an error.
+func getSubnetsByParentSubnetID(c *device42.API, id int) ([]device42.Subnet, error) {
+	subnets, err := getSubnets(c, url.Values{"parent_subnet_id": {strconv.Itoa(id)}}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return *subnets, nil
+}
+
+// getSubnetUsage will return the usage of a subnet along with its children.
+// the usage of an IPv6 subnet is left empty, since its address count doesn't
+// fit in an int.
+func getSubnetUsage(c *device42.API, subnet *device42.Subnet) (subnetUsage, []device42.Subnet, error) {
+	children, err := getSubnetsByParentSubnetID(c, subnet.SubnetID)
+	if err != nil {
+		return subnetUsage{}, nil, err
+	}
+
+	if _, ok := ipv4ToUint32(net.ParseIP(subnet.Network)); !ok {
+		return subnetUsage{}, children, nil
+	}
+
+	ips, err := getIPs(c, url.Values{"subnet_id": {strconv.Itoa(subnet.SubnetID)}}, 0)
+	if err != nil {
+		return subnetUsage{}, children, err
+	}
+
+	usage, err := calculateSubnetUsage(subnet, ips, children)
+	if err != nil {
+		return subnetUsage{}, children, err
+	}
+
+	return usage, children, nil
+}
+
+// flatten free ranges to a map
+func flattenFreeRanges(ranges []ipv4Range) []interface{} {
+	fr := make([]interface{}, len(ranges))
+	for i, r := range ranges {
+		fr[i] = map[string]interface{}{
+			"start": uint32ToIPv4(r.start),
+			"end":   uint32ToIPv4(r.end),
+			"count": int(r.end-r.start) + 1,
+		}
+	}
+	return fr
+}
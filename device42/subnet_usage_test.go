@@ -0,0 +1,187 @@
+package device42
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	device42 "github.com/chopnico/device42-go"
+)
+
+func testSubnet(network string, maskBits int) *device42.Subnet {
+	return &device42.Subnet{Network: network, MaskBits: maskBits}
+}
+
+func testIPs(addresses ...string) []device42.IP {
+	ips := make([]device42.IP, len(addresses))
+	for n, a := range addresses {
+		ips[n] = device42.IP{Address: a}
+	}
+	return ips
+}
+
+func TestCalculateSubnetUsage(t *testing.T) {
+	tests := []struct {
+		name      string
+		subnet    *device42.Subnet
+		ips       []device42.IP
+		children  []device42.Subnet
+		total     int
+		used      int
+		freeRange [][2]string
+	}{
+		{
+			name:      "empty /24",
+			subnet:    testSubnet("10.0.0.0", 24),
+			total:     254,
+			freeRange: [][2]string{{"10.0.0.1", "10.0.0.254"}},
+		},
+		{
+			name:      "network and broadcast allowed",
+			subnet:    &device42.Subnet{Network: "10.0.0.0", MaskBits: 30, AllowNetworkAddress: "yes", AllowBroadcastAddress: "yes"},
+			total:     4,
+			freeRange: [][2]string{{"10.0.0.0", "10.0.0.3"}},
+		},
+		{
+			name:      "ips split the free range",
+			subnet:    testSubnet("10.0.0.0", 29),
+			ips:       testIPs("10.0.0.1", "10.0.0.4", "10.0.0.5"),
+			total:     6,
+			used:      3,
+			freeRange: [][2]string{{"10.0.0.2", "10.0.0.3"}, {"10.0.0.6", "10.0.0.6"}},
+		},
+		{
+			name:   "available ips and ips outside of the subnet are free",
+			subnet: testSubnet("10.0.0.0", 29),
+			ips: []device42.IP{
+				{Address: "10.0.0.1", Available: "yes"},
+				{Address: "10.0.0.0"},
+				{Address: "10.0.1.1"},
+				{Address: "fd00::1"},
+			},
+			total:     6,
+			freeRange: [][2]string{{"10.0.0.1", "10.0.0.6"}},
+		},
+		{
+			name:      "child subnets",
+			subnet:    testSubnet("10.0.0.0", 24),
+			ips:       testIPs("10.0.0.20"),
+			children:  []device42.Subnet{*testSubnet("10.0.0.128", 25), *testSubnet("10.0.0.8", 29)},
+			total:     254,
+			used:      1 + 127 + 8,
+			freeRange: [][2]string{{"10.0.0.1", "10.0.0.7"}, {"10.0.0.16", "10.0.0.19"}, {"10.0.0.21", "10.0.0.127"}},
+		},
+		{
+			name:      "ips inside of a child subnet are counted once",
+			subnet:    testSubnet("10.0.0.0", 28),
+			ips:       testIPs("10.0.0.9"),
+			children:  []device42.Subnet{*testSubnet("10.0.0.8", 29)},
+			total:     14,
+			used:      7,
+			freeRange: [][2]string{{"10.0.0.1", "10.0.0.7"}},
+		},
+		{
+			name:   "full",
+			subnet: testSubnet("10.0.0.0", 30),
+			ips:    testIPs("10.0.0.1", "10.0.0.2"),
+			total:  2,
+			used:   2,
+		},
+		{
+			name:      "/31 has no network or broadcast address",
+			subnet:    testSubnet("10.0.0.0", 31),
+			ips:       testIPs("10.0.0.0"),
+			total:     2,
+			used:      1,
+			freeRange: [][2]string{{"10.0.0.1", "10.0.0.1"}},
+		},
+		{
+			name:      "/32",
+			subnet:    testSubnet("10.0.0.7", 32),
+			total:     1,
+			freeRange: [][2]string{{"10.0.0.7", "10.0.0.7"}},
+		},
+		{
+			name:   "used /32",
+			subnet: testSubnet("10.0.0.7", 32),
+			ips:    testIPs("10.0.0.7"),
+			total:  1,
+			used:   1,
+		},
+		{
+			name:      "end of the address space",
+			subnet:    testSubnet("255.255.255.252", 30),
+			ips:       testIPs("255.255.255.253"),
+			total:     2,
+			used:      1,
+			freeRange: [][2]string{{"255.255.255.254", "255.255.255.254"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usage, err := calculateSubnetUsage(tt.subnet, tt.ips, tt.children)
+			if err != nil {
+				t.Fatalf("calculateSubnetUsage() error = %v", err)
+			}
+
+			if usage.Total != tt.total || usage.Used != tt.used || usage.Free != tt.total-tt.used {
+				t.Errorf("calculateSubnetUsage() total %d, used %d, free %d, want %d, %d, %d",
+					usage.Total, usage.Used, usage.Free, tt.total, tt.used, tt.total-tt.used)
+			}
+
+			freeRange := make([][2]string, 0)
+			for _, r := range usage.FreeRange {
+				freeRange = append(freeRange, [2]string{uint32ToIPv4(r.start), uint32ToIPv4(r.end)})
+			}
+			if tt.freeRange == nil {
+				tt.freeRange = [][2]string{}
+			}
+			if !reflect.DeepEqual(freeRange, tt.freeRange) {
+				t.Errorf("calculateSubnetUsage() free ranges %v, want %v", freeRange, tt.freeRange)
+			}
+		})
+	}
+}
+
+func TestCalculateSubnetUsageIPv6(t *testing.T) {
+	if _, err := calculateSubnetUsage(testSubnet("fd00::", 64), nil, nil); err == nil {
+		t.Error("calculateSubnetUsage() error = nil, want an error for an IPv6 subnet")
+	}
+}
+
+func TestUtilizationPercent(t *testing.T) {
+	if got := (subnetUsage{}).UtilizationPercent(); got != 0 {
+		t.Errorf("UtilizationPercent() = %v, want 0", got)
+	}
+	if got := (subnetUsage{Total: 4, Used: 1}).UtilizationPercent(); got != 25 {
+		t.Errorf("UtilizationPercent() = %v, want 25", got)
+	}
+}
+
+func TestGetSubnetUsageIPv6(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/1.0/subnets/" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"subnets":     []device42.Subnet{{SubnetID: 2, Network: "fd00::", MaskBits: 64}},
+			"total_count": 1,
+		})
+	})
+	c := newTestAPI(t, h)
+
+	usage, children, err := getSubnetUsage(c, &device42.Subnet{SubnetID: 1, Network: "fd00::", MaskBits: 48})
+	if err != nil {
+		t.Fatalf("getSubnetUsage() error = %v", err)
+	}
+	if usage.Total != 0 || len(usage.FreeRange) != 0 {
+		t.Errorf("getSubnetUsage() usage = %v, want no usage", usage)
+	}
+	if len(children) != 1 || children[0].SubnetID != 2 {
+		t.Errorf("getSubnetUsage() children = %v, want the child subnet", children)
+	}
+}
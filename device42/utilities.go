@@ -3,8 +3,11 @@ package device42
 import (
 	"crypto/md5"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func stringChecksum(s string) string {
@@ -13,16 +16,64 @@ func stringChecksum(s string) string {
 }
 
 func idsToString(ids []int) string {
-	s := make([]string, len(ids))
+	s := make([]string, 0, len(ids))
 	for _, i := range ids {
 		s = append(s, strconv.FormatInt(int64(i), 10))
 	}
 
-	return strings.Join(s, "")
+	return strings.Join(s, ",")
 }
 
+// idsChecksum is the same for the same ids in any order
 func idsChecksum(ids []int) string {
-	return stringChecksum(idsToString(ids))
+	sorted := make([]int, len(ids))
+	copy(sorted, ids)
+	sort.Ints(sorted)
+
+	return stringChecksum(idsToString(sorted))
+}
+
+// listDataSourceID will return the id of a list data source. it is derived
+// from every argument of r and the ids of the results, so the same query over
+// the same objects always gets the same id.
+func listDataSourceID(d *schema.ResourceData, r *schema.Resource, ids []int) string {
+	keys := make([]string, 0, len(r.Schema))
+	for k, s := range r.Schema {
+		if s.Required || s.Optional {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	s := make([]string, 0, len(keys)+1)
+	for _, k := range keys {
+		s = append(s, fmt.Sprintf("%s=%v", k, flattenSets(d.Get(k))))
+	}
+	s = append(s, "ids="+idsChecksum(ids))
+
+	return stringChecksum(strings.Join(s, ";"))
+}
+
+// flattenSets will replace every set in v with the list of its values, so it
+// is formatted by its values and not by its address
+func flattenSets(v interface{}) interface{} {
+	switch t := v.(type) {
+	case *schema.Set:
+		return flattenSets(t.List())
+	case []interface{}:
+		l := make([]interface{}, len(t))
+		for n, i := range t {
+			l[n] = flattenSets(i)
+		}
+		return l
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for k, i := range t {
+			m[k] = flattenSets(i)
+		}
+		return m
+	}
+	return v
 }
 
 func interfaceSliceToStringSlice(i []interface{}) []string {
@@ -47,3 +98,44 @@ func ipv4GatewayFromNetwork(network string) string {
 
 	return strings.Join(firstThreeOctets, ".") + "." + strconv.Itoa(i+1)
 }
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+func interfaceSliceToIntSlice(i []interface{}) []int {
+	var s []int = make([]int, len(i))
+	for n, d := range i {
+		s[n] = d.(int)
+	}
+	return s
+}
+
+func intsToCommaString(ids []int) string {
+	s := make([]string, len(ids))
+	for n, i := range ids {
+		s[n] = strconv.Itoa(i)
+	}
+	return strings.Join(s, ",")
+}
+
+func intInSlice(i int, s []int) bool {
+	for _, v := range s {
+		if v == i {
+			return true
+		}
+	}
+	return false
+}
+
+func stringInSlice(i string, s []string) bool {
+	for _, v := range s {
+		if v == i {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,209 @@
+package device42
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestIDsChecksum(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     []int
+		b     []int
+		equal bool
+	}{
+		{
+			name:  "same order",
+			a:     []int{1, 2, 3},
+			b:     []int{1, 2, 3},
+			equal: true,
+		},
+		{
+			name:  "any order",
+			a:     []int{3, 1, 2},
+			b:     []int{2, 3, 1},
+			equal: true,
+		},
+		{
+			name:  "digits aren't joined",
+			a:     []int{1, 23},
+			b:     []int{12, 3},
+			equal: false,
+		},
+		{
+			name:  "zero isn't dropped",
+			a:     []int{0, 1},
+			b:     []int{1},
+			equal: false,
+		},
+		{
+			name:  "zero prefix",
+			a:     []int{1, 2},
+			b:     []int{0, 1, 2},
+			equal: false,
+		},
+		{
+			name:  "empty",
+			a:     []int{},
+			b:     nil,
+			equal: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := idsChecksum(tt.a), idsChecksum(tt.b)
+			if (a == b) != tt.equal {
+				t.Errorf("idsChecksum(%v) = %s, idsChecksum(%v) = %s, want equal %v", tt.a, a, tt.b, b, tt.equal)
+			}
+		})
+	}
+}
+
+func TestIDsChecksumDoesNotSort(t *testing.T) {
+	ids := []int{3, 1, 2}
+	idsChecksum(ids)
+
+	if ids[0] != 3 || ids[1] != 1 || ids[2] != 2 {
+		t.Errorf("idsChecksum() changed the ids to %v", ids)
+	}
+}
+
+func TestFlattenSets(t *testing.T) {
+	// the same values hashed by another function, as another build of the
+	// provider would have
+	hash := func(v interface{}) int { return schema.HashString(v) }
+	a := schema.NewSet(schema.HashString, []interface{}{"x", "y"})
+	b := schema.NewSet(hash, []interface{}{"y", "x"})
+
+	for _, v := range [][2]interface{}{
+		{a, b},
+		{[]interface{}{a}, []interface{}{b}},
+		{map[string]interface{}{"tags": a}, map[string]interface{}{"tags": b}},
+	} {
+		got, want := fmt.Sprintf("%v", flattenSets(v[0])), fmt.Sprintf("%v", flattenSets(v[1]))
+		if got != want {
+			t.Errorf("flattenSets() = %s, want %s", got, want)
+		}
+	}
+}
+
+func testListDataSource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"filter": filterSchema(),
+			"items": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+		},
+	}
+}
+
+func TestListDataSourceID(t *testing.T) {
+	r := testListDataSource()
+	base := map[string]interface{}{
+		"name": "a",
+		"tags": []interface{}{"x", "y"},
+		"filter": []interface{}{
+			map[string]interface{}{"name": "name", "values": []interface{}{"a"}},
+		},
+	}
+	id := func(raw map[string]interface{}, ids []int) string {
+		return listDataSourceID(schema.TestResourceDataRaw(t, r.Schema, raw), r, ids)
+	}
+	want := id(base, []int{1, 2})
+
+	tests := []struct {
+		name  string
+		raw   map[string]interface{}
+		ids   []int
+		equal bool
+	}{
+		{
+			name:  "same arguments and ids",
+			raw:   base,
+			ids:   []int{1, 2},
+			equal: true,
+		},
+		{
+			name:  "ids in another order",
+			raw:   base,
+			ids:   []int{2, 1},
+			equal: true,
+		},
+		{
+			name: "set in another order",
+			raw: map[string]interface{}{
+				"name":   "a",
+				"tags":   []interface{}{"y", "x"},
+				"filter": base["filter"],
+			},
+			ids:   []int{1, 2},
+			equal: true,
+		},
+		{
+			name:  "other ids",
+			raw:   base,
+			ids:   []int{1, 3},
+			equal: false,
+		},
+		{
+			name: "other string argument",
+			raw: map[string]interface{}{
+				"name":   "b",
+				"tags":   base["tags"],
+				"filter": base["filter"],
+			},
+			ids:   []int{1, 2},
+			equal: false,
+		},
+		{
+			name: "other set argument",
+			raw: map[string]interface{}{
+				"name":   "a",
+				"tags":   []interface{}{"x", "z"},
+				"filter": base["filter"],
+			},
+			ids:   []int{1, 2},
+			equal: false,
+		},
+		{
+			name: "other filter",
+			raw: map[string]interface{}{
+				"name": "a",
+				"tags": base["tags"],
+				"filter": []interface{}{
+					map[string]interface{}{"name": "name", "values": []interface{}{"b"}},
+				},
+			},
+			ids:   []int{1, 2},
+			equal: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := id(tt.raw, tt.ids)
+			if (got == want) != tt.equal {
+				t.Errorf("listDataSourceID() = %s, base id %s, want equal %v", got, want, tt.equal)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package device42
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// validateRouteDistinguisher will make sure a route distinguisher or route
+// target is in one of the formats of RFC 4364, ASN:nn or IP:nn
+func validateRouteDistinguisher(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if err := parseRouteDistinguisher(v); err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be in the format ASN:nn or IP:nn, got %s : %s", k, v, err.Error())}
+	}
+
+	return nil, nil
+}
+
+func parseRouteDistinguisher(v string) error {
+	n := strings.LastIndex(v, ":")
+	if n < 1 || n == len(v)-1 {
+		return fmt.Errorf("missing administrator or assigned number")
+	}
+	admin, assigned := v[:n], v[n+1:]
+
+	number, err := strconv.ParseUint(assigned, 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid assigned number %s", assigned)
+	}
+
+	// type 1, an IPv4 address and a 2 byte number
+	if ip := net.ParseIP(admin); ip != nil {
+		if ip.To4() == nil {
+			return fmt.Errorf("%s is not an IPv4 address", admin)
+		}
+		if number > math.MaxUint16 {
+			return fmt.Errorf("assigned number %d is more than %d", number, math.MaxUint16)
+		}
+		return nil
+	}
+
+	asn, err := strconv.ParseUint(admin, 10, 32)
+	if err != nil {
+		return fmt.Errorf("%s is not an ASN or an IPv4 address", admin)
+	}
+
+	// type 0 is a 2 byte ASN and a 4 byte number, type 2 is a 4 byte ASN
+	// and a 2 byte number
+	if asn > math.MaxUint16 && number > math.MaxUint16 {
+		return fmt.Errorf("assigned number %d is more than %d for a 4 byte ASN", number, math.MaxUint16)
+	}
+
+	return nil
+}
+
+// dnsRecordTypes are the dns record types that can be managed
+var dnsRecordTypes = []string{"A", "AAAA", "CNAME", "PTR", "TXT", "SRV", "MX"}
+
+var hostnameLabel = regexp.MustCompile(`^[A-Za-z0-9_]([A-Za-z0-9_-]{0,61}[A-Za-z0-9_])?$`)
+
+// validateHostname will make sure a name is a valid dns name. a trailing dot
+// is allowed.
+func validateHostname(v string) error {
+	name := strings.TrimSuffix(v, ".")
+	if name == "" || len(name) > 253 {
+		return fmt.Errorf("%s is not a valid hostname", v)
+	}
+	for _, label := range strings.Split(name, ".") {
+		if !hostnameLabel.MatchString(label) {
+			return fmt.Errorf("%s is not a valid hostname", v)
+		}
+	}
+	return nil
+}
+
+// validateDNSName will make sure an attribute is a valid dns name
+func validateDNSName(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if err := validateHostname(v); err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be a dns name : %s", k, err.Error())}
+	}
+
+	return nil, nil
+}
+
+// validateDNSRecordContent will make sure the content of a dns record is
+// valid for its type
+func validateDNSRecordContent(recordType, content string) error {
+	switch recordType {
+	case "A":
+		if ip := net.ParseIP(content); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("content of an A record must be an IPv4 address, got %s", content)
+		}
+	case "AAAA":
+		if ip := net.ParseIP(content); ip == nil || ip.To4() != nil {
+			return fmt.Errorf("content of an AAAA record must be an IPv6 address, got %s", content)
+		}
+	case "CNAME", "PTR":
+		if err := validateHostname(content); err != nil {
+			return fmt.Errorf("content of a %s record must be a hostname : %s", recordType, err.Error())
+		}
+	case "MX":
+		if err := validateHostname(content); err != nil {
+			return fmt.Errorf("content of an MX record must be a hostname : %s", err.Error())
+		}
+	case "SRV":
+		// weight port target
+		f := strings.Fields(content)
+		if len(f) != 3 {
+			return fmt.Errorf("content of an SRV record must be \"weight port target\", got %s", content)
+		}
+		for _, n := range f[:2] {
+			if _, err := strconv.ParseUint(n, 10, 16); err != nil {
+				return fmt.Errorf("weight and port of an SRV record must be between 0 and %d, got %s", math.MaxUint16, n)
+			}
+		}
+		if err := validateHostname(f[2]); err != nil {
+			return fmt.Errorf("target of an SRV record must be a hostname : %s", err.Error())
+		}
+	case "TXT":
+		if content == "" {
+			return fmt.Errorf("content of a TXT record can't be empty")
+		}
+	default:
+		return fmt.Errorf("unsupported record type %s", recordType)
+	}
+
+	return nil
+}
+
+// normalizeMACAddress will return a MAC address as lower case, colon
+// separated hex. colon, hyphen, dot and bare hex notations are accepted.
+func normalizeMACAddress(v string) (string, error) {
+	s := strings.TrimSpace(v)
+	if len(s) == 12 && !strings.ContainsAny(s, ":-.") {
+		parts := make([]string, 6)
+		for i := range parts {
+			parts[i] = s[i*2 : i*2+2]
+		}
+		s = strings.Join(parts, ":")
+	}
+
+	mac, err := net.ParseMAC(s)
+	if err != nil {
+		return "", fmt.Errorf("%s is not a MAC address", v)
+	}
+	if len(mac) != 6 {
+		return "", fmt.Errorf("%s is not a 48 bit MAC address", v)
+	}
+
+	return mac.String(), nil
+}
+
+// validateMACAddress will make sure an attribute is a MAC address
+func validateMACAddress(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if _, err := normalizeMACAddress(v); err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be a MAC address : %s", k, err.Error())}
+	}
+
+	return nil, nil
+}
+
+// macAddressStateFunc will store a MAC address in its normalized form
+func macAddressStateFunc(i interface{}) string {
+	v, err := normalizeMACAddress(i.(string))
+	if err != nil {
+		return i.(string)
+	}
+	return v
+}
+
+// dateFormat is the format the appliance takes and returns dates in
+const dateFormat = "2006-01-02"
+
+// validateDate will make sure an attribute is a date in the format YYYY-MM-DD
+func validateDate(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if _, err := time.Parse(dateFormat, v); err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be a date in the format YYYY-MM-DD, got %s", k, v)}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,184 @@
+package device42
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseRouteDistinguisher(t *testing.T) {
+	tests := []struct {
+		value string
+		valid bool
+	}{
+		// type 0, a 2 byte ASN and a 4 byte number
+		{value: "65000:100", valid: true},
+		{value: "65535:4294967295", valid: true},
+		{value: "0:0", valid: true},
+		// type 2, a 4 byte ASN and a 2 byte number
+		{value: "65536:65535", valid: true},
+		{value: "4294967295:1", valid: true},
+		{value: "65536:65536", valid: false},
+		{value: "4200000000:100000", valid: false},
+		{value: "4294967296:1", valid: false},
+		// type 1, an IPv4 address and a 2 byte number
+		{value: "192.0.2.1:65535", valid: true},
+		{value: "192.0.2.1:65536", valid: false},
+		{value: "192.0.2.256:1", valid: false},
+		// an IPv6 administrator isn't a format of RFC 4364
+		{value: "2001:db8::1:100", valid: false},
+		{value: "[2001:db8::1]:100", valid: false},
+		{value: "::1", valid: false},
+		// missing or invalid parts
+		{value: "", valid: false},
+		{value: "65000", valid: false},
+		{value: "65000:", valid: false},
+		{value: ":100", valid: false},
+		{value: "65000:4294967296", valid: false},
+		{value: "65000:-1", valid: false},
+		{value: "as65000:100", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			err := parseRouteDistinguisher(tt.value)
+			if (err == nil) != tt.valid {
+				t.Errorf("parseRouteDistinguisher(%q) error = %v, want valid %v", tt.value, err, tt.valid)
+			}
+		})
+	}
+}
+
+func TestValidateRouteDistinguisher(t *testing.T) {
+	if _, errs := validateRouteDistinguisher("65000:100", "rd"); len(errs) != 0 {
+		t.Errorf("validateRouteDistinguisher() errors = %v, want none", errs)
+	}
+	if _, errs := validateRouteDistinguisher("65536:65536", "rd"); len(errs) != 1 {
+		t.Errorf("validateRouteDistinguisher() errors = %v, want one", errs)
+	}
+	if _, errs := validateRouteDistinguisher(65000, "rd"); len(errs) != 1 {
+		t.Errorf("validateRouteDistinguisher() errors = %v, want one for a number", errs)
+	}
+}
+
+func TestValidateHostname(t *testing.T) {
+	tests := []struct {
+		value string
+		valid bool
+	}{
+		{value: "host", valid: true},
+		{value: "host.example.com", valid: true},
+		{value: "host.example.com.", valid: true},
+		{value: "_sip._tcp.example.com", valid: true},
+		{value: "1.2.0.192.in-addr.arpa", valid: true},
+		{value: "", valid: false},
+		{value: ".", valid: false},
+		{value: "host..example.com", valid: false},
+		{value: "-host.example.com", valid: false},
+		{value: "host-.example.com", valid: false},
+		{value: "host name.example.com", valid: false},
+		{value: strings.Repeat("a", 64) + ".example.com", valid: false},
+		{value: strings.Repeat("a.", 127) + "a", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			err := validateHostname(tt.value)
+			if (err == nil) != tt.valid {
+				t.Errorf("validateHostname(%q) error = %v, want valid %v", tt.value, err, tt.valid)
+			}
+		})
+	}
+}
+
+func TestValidateDNSRecordContent(t *testing.T) {
+	tests := []struct {
+		recordType string
+		content    string
+		valid      bool
+	}{
+		{recordType: "A", content: "192.0.2.1", valid: true},
+		{recordType: "A", content: "2001:db8::1", valid: false},
+		{recordType: "A", content: "host.example.com", valid: false},
+		{recordType: "AAAA", content: "2001:db8::1", valid: true},
+		{recordType: "AAAA", content: "192.0.2.1", valid: false},
+		{recordType: "AAAA", content: "::ffff:192.0.2.1", valid: false},
+		{recordType: "CNAME", content: "host.example.com.", valid: true},
+		{recordType: "CNAME", content: "192.0.2.1:80", valid: false},
+		{recordType: "PTR", content: "host.example.com", valid: true},
+		{recordType: "PTR", content: "", valid: false},
+		{recordType: "MX", content: "mail.example.com", valid: true},
+		{recordType: "MX", content: "mail.example.com.", valid: true},
+		{recordType: "MX", content: "10 mail.example.com", valid: false},
+		{recordType: "MX", content: "", valid: false},
+		{recordType: "SRV", content: "10 5060 sip.example.com", valid: true},
+		{recordType: "SRV", content: "0 65535 sip.example.com.", valid: true},
+		{recordType: "SRV", content: "  10   5060   sip.example.com  ", valid: true},
+		{recordType: "SRV", content: "10 65536 sip.example.com", valid: false},
+		{recordType: "SRV", content: "-1 5060 sip.example.com", valid: false},
+		{recordType: "SRV", content: "10 sip 5060", valid: false},
+		{recordType: "SRV", content: "10 5060", valid: false},
+		{recordType: "SRV", content: "1 10 5060 sip.example.com", valid: false},
+		{recordType: "SRV", content: "10 5060 -sip.example.com", valid: false},
+		{recordType: "TXT", content: "v=spf1 -all", valid: true},
+		{recordType: "TXT", content: "\"quoted text\"", valid: true},
+		{recordType: "TXT", content: "", valid: false},
+		{recordType: "NS", content: "ns.example.com", valid: false},
+		{recordType: "a", content: "192.0.2.1", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.recordType+" "+tt.content, func(t *testing.T) {
+			err := validateDNSRecordContent(tt.recordType, tt.content)
+			if (err == nil) != tt.valid {
+				t.Errorf("validateDNSRecordContent(%s, %q) error = %v, want valid %v", tt.recordType, tt.content, err, tt.valid)
+			}
+		})
+	}
+}
+
+func TestNormalizeMACAddress(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "00:1a:2b:3c:4d:5e", want: "00:1a:2b:3c:4d:5e"},
+		{value: "00-1A-2B-3C-4D-5E", want: "00:1a:2b:3c:4d:5e"},
+		{value: "001a.2b3c.4d5e", want: "00:1a:2b:3c:4d:5e"},
+		{value: "001A2B3C4D5E", want: "00:1a:2b:3c:4d:5e"},
+		{value: " 00:1a:2b:3c:4d:5e ", want: "00:1a:2b:3c:4d:5e"},
+		// 64 bit EUI and 20 byte infiniband addresses aren't MAC addresses
+		{value: "00:1a:2b:ff:fe:3c:4d:5e", want: ""},
+		{value: "001a.2bff.fe3c.4d5e", want: ""},
+		{value: "001a2bfffe3c4d5e", want: ""},
+		{value: "00:00:00:00:fe:80:00:00:00:00:00:00:02:00:5e:10:00:00:00:01", want: ""},
+		// missing or invalid parts
+		{value: "", want: ""},
+		{value: "00:1a:2b:3c:4d", want: ""},
+		{value: "001a2b3c4d5", want: ""},
+		{value: "001a2b3c4d5g", want: ""},
+		{value: "00:1a:2b:3c:4d:5g", want: ""},
+		{value: "00:1a-2b:3c-4d:5e", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := normalizeMACAddress(tt.value)
+			if (err == nil) != (tt.want != "") {
+				t.Fatalf("normalizeMACAddress(%q) error = %v, want valid %v", tt.value, err, tt.want != "")
+			}
+			if got != tt.want {
+				t.Errorf("normalizeMACAddress(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMACAddressStateFunc(t *testing.T) {
+	if got := macAddressStateFunc("001A.2B3C.4D5E"); got != "00:1a:2b:3c:4d:5e" {
+		t.Errorf("macAddressStateFunc() = %q, want 00:1a:2b:3c:4d:5e", got)
+	}
+	// an invalid value is kept so validation reports it as it was written
+	if got := macAddressStateFunc("not a mac"); got != "not a mac" {
+		t.Errorf("macAddressStateFunc() = %q, want not a mac", got)
+	}
+}